@@ -0,0 +1,10 @@
+package omise
+
+import "github.com/omise/omise-go/internal"
+
+// Amount is a monetary amount in the smallest unit of its currency, e.g. satangs for "thb".
+// It also decodes from numeric strings such as "100000", in case large amounts are ever
+// represented as strings, and encodes as a regular JSON number. It is used for every
+// amount field, including those of the schedule package's ChargeDetail and
+// TransferDetail.
+type Amount = internal.Amount
@@ -0,0 +1,33 @@
+package omise_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/omise/omise-go"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestAmount(t *testing.T) {
+	for _, input := range []string{`100000`, `"100000"`} {
+		charge := &Charge{}
+		r.NoError(t, json.Unmarshal([]byte(`{"amount":`+input+`,"refunded":`+input+`}`), charge))
+		r.Equal(t, Amount(100000), charge.Amount, input)
+		r.Equal(t, Amount(100000), charge.Refunded, input)
+	}
+
+	schd := &Schedule{}
+	r.NoError(t, json.Unmarshal([]byte(`{"charge":{"amount":"100000"}}`), schd))
+	r.Equal(t, Amount(100000), schd.Charge.Amount)
+
+	schd = &Schedule{}
+	r.NoError(t, json.Unmarshal([]byte(`{"transfer":{"amount":"100000"}}`), schd))
+	r.Equal(t, Amount(100000), *schd.Transfer.Amount)
+
+	var a Amount
+	r.Error(t, json.Unmarshal([]byte(`"1000.00"`), &a))
+
+	buffer, e := json.Marshal(Amount(100000))
+	r.NoError(t, e)
+	r.Equal(t, "100000", string(buffer))
+}
@@ -2,9 +2,14 @@ package omise
 
 // Balance represents Omise's balance object.
 // See https://www.omise.co/balance-api for more information.
+//
+// Omise reports a single balance per account, in the account's settlement Currency, even
+// when charges are made in other currencies. There is no per-currency breakdown to decode;
+// amounts charged in other currencies are converted before they are added to Available
+// and Total.
 type Balance struct {
 	Base
-	Available int64  `json:"available" pretty:""`
-	Total     int64  `json:"total" pretty:""`
+	Available Amount `json:"available" pretty:""`
+	Total     Amount `json:"total" pretty:""`
 	Currency  string `json:"currency" pretty:""`
 }
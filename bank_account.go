@@ -2,10 +2,15 @@ package omise
 
 // BankAccount represents Omise's bank account object.
 // See https://www.omise.co/bank-account-api for more information.
+//
+// Number is only sent when creating or updating a recipient. Bank accounts returned by
+// Omise mask the number and only expose its LastDigits. Type is the account type, e.g.
+// "normal" or "current", and is only present for accounts that have one.
 type BankAccount struct {
 	Base
 	Brand      string `json:"brand" pretty:""`
 	Number     string `json:"number"`
 	LastDigits string `json:"last_digits" pretty:""`
 	Name       string `json:"name" pretty:""`
+	Type       string `json:"type"`
 }
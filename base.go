@@ -3,11 +3,16 @@ package omise
 import "time"
 
 // Base structure contains fields that are common to objects returned by the Omise's REST
-// API.
+// API. The Object field holds the type of the resource (e.g. "schedule" or "occurrence")
+// and can be used to decide how to handle a generic payload.
+//
+// Created holds the creation timestamp of every resource, decoded from its RFC 3339 form
+// with the offset sent by the server preserved. Use Created.Before to sort resources by
+// creation time.
 type Base struct {
 	Object   string    `json:"object"`
 	ID       string    `json:"id" pretty:""`
-	Live     bool      `json:"livemode" pretty:""`
+	Live     Bool      `json:"livemode" pretty:""`
 	Location *string   `json:"location"`
 	Created  time.Time `json:"created"`
 }
@@ -0,0 +1,31 @@
+package omise
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Bool is a boolean flag that also decodes from the string forms "true" and "false", which
+// some API versions return in place of JSON booleans. It encodes as a regular JSON boolean
+// and can be used wherever a bool is expected in conditions.
+type Bool bool
+
+// UnmarshalJSON decodes either a JSON boolean or a string holding a boolean.
+func (b *Bool) UnmarshalJSON(buffer []byte) error {
+	if len(buffer) > 0 && buffer[0] == '"' {
+		var s string
+		if e := json.Unmarshal(buffer, &s); e != nil {
+			return e
+		}
+
+		v, e := strconv.ParseBool(s)
+		if e != nil {
+			return e
+		}
+
+		*b = Bool(v)
+		return nil
+	}
+
+	return json.Unmarshal(buffer, (*bool)(b))
+}
@@ -0,0 +1,30 @@
+package omise_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/omise/omise-go"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestBool(t *testing.T) {
+	for input, expected := range map[string]Bool{
+		`true`:    true,
+		`false`:   false,
+		`"true"`:  true,
+		`"false"`: false,
+	} {
+		link := &Link{}
+		r.NoError(t, json.Unmarshal([]byte(`{"livemode":`+input+`,"multiple":`+input+`}`), link))
+		r.Equal(t, expected, link.Live, input)
+		r.Equal(t, expected, link.Multiple, input)
+	}
+
+	var b Bool
+	r.Error(t, json.Unmarshal([]byte(`"yes"`), &b))
+
+	buffer, e := json.Marshal(Bool(true))
+	r.NoError(t, e)
+	r.Equal(t, "true", string(buffer))
+}
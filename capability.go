@@ -0,0 +1,40 @@
+package omise
+
+import (
+	"strings"
+)
+
+// Capability represents Omise's capability object, which lists the payment methods
+// available to the account.
+// See https://www.omise.co/capability-api for more information.
+type Capability struct {
+	Base
+	Banks                    []string         `json:"banks"`
+	PaymentMethods           []*PaymentMethod `json:"payment_methods"`
+	ZeroInterestInstallments bool             `json:"zero_interest_installments"`
+}
+
+// PaymentMethod represents a payment method listed in a Capability.
+type PaymentMethod struct {
+	Object           string   `json:"object"`
+	Name             string   `json:"name"`
+	Currencies       []string `json:"currencies"`
+	CardBrands       []string `json:"card_brands"`
+	InstallmentTerms []int    `json:"installment_terms"`
+}
+
+// MethodsForCurrency returns the payment methods that support the given currency. The
+// currency code is matched case-insensitively.
+func (c *Capability) MethodsForCurrency(currency string) []*PaymentMethod {
+	var result []*PaymentMethod
+	for _, method := range c.PaymentMethods {
+		for _, supported := range method.Currencies {
+			if strings.EqualFold(supported, currency) {
+				result = append(result, method)
+				break
+			}
+		}
+	}
+
+	return result
+}
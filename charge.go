@@ -5,7 +5,7 @@ package omise
 type Charge struct {
 	Base
 	Status      ChargeStatus `json:"status"`
-	Amount      int64        `json:"amount" pretty:""`
+	Amount      Amount       `json:"amount" pretty:""`
 	Currency    string       `json:"currency" pretty:""`
 	Description *string      `json:"description"`
 
@@ -17,7 +17,7 @@ type Charge struct {
 	Transaction string `json:"transaction"`
 	Card        *Card  `json:"card"`
 
-	Refunded       int64       `json:"refunded"`
+	Refunded       Amount      `json:"refunded"`
 	Refunds        *RefundList `json:"refunds"`
 	FailureCode    *string     `json:"failure_code"`
 	FailureMessage *string     `json:"failure_message"`
@@ -25,10 +25,13 @@ type Charge struct {
 	CustomerID string   `json:"customer"`
 	IP         *string  `json:"ip"`
 	Dispute    *Dispute `json:"dispute"`
+	Schedule   string   `json:"schedule"`
 
 	ReturnURI    string `json:"return_uri"`
 	AuthorizeURI string `json:"authorize_uri"`
 
 	SourceOfFund SourceOfFunds `json:"source_of_fund"`
 	Offsite      OffsiteTypes  `json:"offsite"`
+
+	Metadata Metadata `json:"metadata"`
 }
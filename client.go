@@ -2,6 +2,8 @@ package omise
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
 	"fmt"
 	"go/build"
@@ -9,33 +11,105 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/omise/omise-go/internal"
 )
 
 var _ = fmt.Println
 
+// APIEndpoint and VaultEndpoint identify the hosts that can be overridden through the
+// Client.Endpoints map.
+const (
+	APIEndpoint   = internal.API
+	VaultEndpoint = internal.Endpoint(internal.Vault)
+)
+
+// Operation is implemented by every operation accepted by Client.Do. It is exported so that
+// code outside this module can name it, e.g. when setting BeforeSend.
+type Operation = internal.Operation
+
 // Client helps you configure and perform HTTP operations against Omise's REST API. It
 // should be used with operation structures from the operations subpackage.
 type Client struct {
 	*http.Client
-	debug bool
-	pkey  string
-	skey  string
+	debug   bool
+	sandbox bool
+	pkey    string
+	skey    string
+
+	semOnce sync.Once
+	sem     chan struct{}
+
+	rateLimitMutex sync.Mutex
+	rateLimit      RateLimit
+	rateLimitSeen  bool
 
 	// Overrides
 	Endpoints map[internal.Endpoint]string
 
+	// DefaultHeaders are added to every request sent by the client. They take precedence
+	// over the headers set by the client itself, except for authentication.
+	DefaultHeaders http.Header
+
+	// BeforeSend, if set, is called with the marshaled request body of each operation
+	// right before it is sent. The body is a copy so modifying it has no effect on the
+	// request. ctx is the context the request is bound to, so values such as the ID set
+	// by WithCorrelationID can be read from it. Only mutating operations are reported;
+	// GET and HEAD operations, such as lists whose parameters are sent as a JSON body,
+	// are not.
+	BeforeSend func(ctx context.Context, operation Operation, body []byte)
+
 	// configuration
 	APIVersion string
 	GoVersion  string
+
+	// UserAgentSuffix, if set, is appended to the User-Agent header of every request.
+	UserAgentSuffix string
+
+	// Location is the timezone of the Omise account. Omise interprets schedule dates in
+	// the account's timezone so FormatDate uses it when converting a time.Time into a date.
+	Location *time.Location
+
+	// RetryPolicy, if set, retries operations marked as retryable on transient failures.
+	// Only idempotent operations (e.g. list and retrieve) are marked as retryable.
+	RetryPolicy *RetryPolicy
+
+	// BaseContext, if set, is the context every request is derived from. Cancelling it
+	// aborts all requests in flight and pending retries, e.g. during a graceful shutdown.
+	// Contexts given to DoWithContext are still honored; requests are aborted when either
+	// context is done.
+	BaseContext context.Context
+
+	// CompressRequests, if set, gzips the body of every mutating request sent by Do and its
+	// variants and adds a "Content-Encoding: gzip" header. GET and HEAD requests, including
+	// lists, are sent uncompressed. Omise does not document support for compressed request
+	// bodies, so only enable it for endpoints known to accept them. BeforeSend still
+	// receives the uncompressed body.
+	CompressRequests bool
+
+	// MaxConcurrency limits the number of requests in flight at once. Calls to Do block
+	// until a slot is available. Zero means no limit. Must be set before the first request.
+	MaxConcurrency int
 }
 
 // NewClient creates and returns a Client with the given public key and secret key.  Signs
 // in to http://omise.co and visit https://dashboard.omise.co/test/dashboard to obtain
 // your test (or live) keys.
-func NewClient(pkey, skey string) (*Client, error) {
+//
+// Options are applied in order after the defaults are set.
+//
+// Example:
+//
+//	client, e := omise.NewClient(pkey, skey,
+//		omise.WithAPIVersion("2019-05-29"),
+//		omise.WithUserAgentSuffix("myapp/1.0"),
+//	)
+//
+func NewClient(pkey, skey string, options ...Option) (*Client, error) {
 	switch {
 	case pkey == "" && skey == "":
 		return nil, ErrInvalidKey
@@ -58,12 +132,106 @@ func NewClient(pkey, skey string) (*Client, error) {
 		client.GoVersion = build.Default.ReleaseTags[len(build.Default.ReleaseTags)-1]
 	}
 
+	for _, option := range options {
+		option(client)
+	}
+
+	if client.sandbox {
+		if e := client.RequireTestMode(); e != nil {
+			return nil, e
+		}
+	}
+
 	return client, nil
 }
 
+// Close releases the idle keep-alive connections held by the client's transport so that
+// short-lived programs can exit promptly. It is a no-op for transports that do not pool
+// connections. The client remains usable afterwards. Close always returns nil; the error
+// result only lets Client satisfy io.Closer.
+func (c *Client) Close() error {
+	if c.Client != nil {
+		c.Client.CloseIdleConnections()
+	}
+
+	return nil
+}
+
+// RequireTestMode returns ErrLiveKey if either of the configured keys is a live key. Use
+// this to guard jobs that must never run against live data, such as integration tests.
+func (c *Client) RequireTestMode() error {
+	if strings.HasPrefix(c.pkey, "pkey_live_") || strings.HasPrefix(c.skey, "skey_live_") {
+		return ErrLiveKey
+	}
+
+	return nil
+}
+
+// FormatDate formats the given time as a "2006-01-02" date string, as expected by the
+// StartDate and EndDate fields of schedule operations. The date is taken in the client's
+// Location if set, otherwise in the time's own location.
+//
+// Example:
+//
+//	client.Location, _ = time.LoadLocation("Asia/Bangkok")
+//	create := &operations.CreateChargeSchedule{
+//		StartDate: client.FormatDate(time.Now()),
+//		// ...
+//	}
+//
+func (c *Client) FormatDate(t time.Time) string {
+	if c.Location != nil {
+		t = t.In(c.Location)
+	}
+
+	return t.Format("2006-01-02")
+}
+
+// ServerNow returns the current time according to Omise's servers, as reported by the Date
+// header of a cheap GET /account request. Use it in place of time.Now() when computing
+// relative filters, such as a list's From field, on hosts whose clock may drift. The
+// header has a resolution of one second. The request is sent like any other operation, so
+// a non-2xx response is returned as an *Error rather than a time.
+//
+// Example:
+//
+//	now, e := client.ServerNow()
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	list := &operations.ListSchedules{}
+//	list.From = now.Add(-24 * time.Hour)
+//
+func (c *Client) ServerNow() (time.Time, error) {
+	op := &internal.Op{
+		Endpoint: internal.API,
+		Method:   "GET",
+		Path:     "/account",
+	}
+
+	header, e := c.send(c.baseContext(), nil, op, nil)
+	if e != nil {
+		return time.Time{}, e
+	}
+
+	date, e := http.ParseTime(header.Get("Date"))
+	if e != nil {
+		return time.Time{}, ErrNoServerTime
+	}
+
+	return date, nil
+}
+
 // Request creates a new *http.Request that should performs the supplied Operation. Most
 // people should use the Do method instead.
 func (c *Client) Request(operation internal.Operation) (*http.Request, error) {
+	if v, ok := operation.(internal.Validator); ok {
+		if e := v.Validate(); e != nil {
+			return nil, e
+		}
+	}
+
 	var req *http.Request
 	var e error
 	if _, ok := operation.(json.Marshaler); ok {
@@ -81,6 +249,13 @@ func (c *Client) Request(operation internal.Operation) (*http.Request, error) {
 		return nil, e
 	}
 
+	if cond, ok := operation.(internal.Conditional); ok {
+		if since := cond.ModifiedSince(); !since.IsZero() {
+			req.Header.Set("If-Modified-Since", since.UTC().Format(http.TimeFormat))
+		}
+	}
+
+	setHeaders(req, c.DefaultHeaders)
 	return req, nil
 }
 
@@ -106,7 +281,7 @@ func (c *Client) buildQuery(operation internal.Operation) (url.Values, error) {
 func (c *Client) buildJSONRequest(operation internal.Operation) (*http.Request, error) {
 	op := operation.Op()
 
-	b, e := json.Marshal(operation)
+	b, e := Marshal(operation)
 	if e != nil {
 		return nil, e
 	}
@@ -156,6 +331,9 @@ func (c *Client) setRequestHeaders(req *http.Request, op *internal.Op) error {
 	if c.GoVersion != "" {
 		ua += " Go/" + c.GoVersion
 	}
+	if c.UserAgentSuffix != "" {
+		ua += " " + c.UserAgentSuffix
+	}
 
 	// Fallback between migrate to application/json
 	if op.ContentType == "" {
@@ -188,34 +366,237 @@ func (c *Client) setRequestHeaders(req *http.Request, op *internal.Op) error {
 // If the operation is successful, result should contains the response data. Otherwise a
 // non-nil error should be returned. Error maybe of the omise-go.Error struct type, in
 // which case you can further inspect the Code and Message field for more information.
+// Failures in sending the request or reading the response are returned as an
+// *OperationError which records the operation being performed. Responses that cannot be
+// decoded are returned as a *DecodeError.
+//
+// If a non-2xx response carries an object other than an error, e.g. a failed charge, it is
+// still decoded into result alongside the returned *Error.
+//
+// Operations marked as retryable, and operations sent with an Idempotency-Key header,
+// whether through DoWithHeader or DefaultHeaders, are retried according to the
+// RetryPolicy, if set.
 func (c *Client) Do(result interface{}, operation internal.Operation) error {
+	return c.DoWithHeader(result, operation, nil)
+}
+
+// DoWithHeader performs the supplied operation just like Do but also adds the given
+// headers to the request. These take precedence over the client's DefaultHeaders.
+//
+// Example:
+//
+//	header := http.Header{}
+//	header.Set("X-Trace-ID", traceID)
+//	if e := client.DoWithHeader(charge, retrieve, header); e != nil {
+//		panic(e)
+//	}
+//
+func (c *Client) DoWithHeader(result interface{}, operation internal.Operation, header http.Header) error {
+	return c.doWithContext(c.baseContext(), result, operation, header)
+}
+
+// DoWithContext performs the supplied operation just like Do but binds the request to the
+// given context. Cancelling the context aborts the request, any wait for a free
+// MaxConcurrency slot and any pending retry, in which case the context's error is
+// returned.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//
+//	if e := client.DoWithContext(ctx, charge, retrieve); e != nil {
+//		panic(e)
+//	}
+//
+func (c *Client) DoWithContext(ctx context.Context, result interface{}, operation internal.Operation) error {
+	return c.doWithContext(ctx, result, operation, nil)
+}
+
+func (c *Client) doWithContext(ctx context.Context, result interface{}, operation internal.Operation, header http.Header) error {
+	if base := c.BaseContext; base != nil && base != ctx {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+
+		go func() {
+			select {
+			case <-base.Done():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	policy := c.RetryPolicy
+	if policy == nil || !(operation.Op().Retryable || c.idempotencyKey(header) != "") {
+		return c.do(ctx, result, operation, header)
+	}
+
+	var deadline time.Time
+	if policy.MaxElapsed > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, policy.MaxElapsed)
+		defer cancel()
+
+		deadline, _ = ctx.Deadline()
+	}
+
+	for attempt := 0; ; attempt++ {
+		e := c.do(ctx, result, operation, header)
+		if attempt >= policy.MaxRetries || !isTransient(e) || ctx.Err() != nil {
+			return e
+		}
+
+		backoff := policy.backoff(attempt)
+		if !deadline.IsZero() && time.Now().Add(backoff).After(deadline) {
+			return e
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Follow retrieves the resource at the given location, such as the Location field of a
+// list or object returned by Omise, and decodes it into result. The location may be a path
+// relative to the API endpoint or an absolute URL of one of the client's endpoints. Other
+// URLs are rejected with ErrForeignLocation so that keys are never sent elsewhere.
+//
+// Example:
+//
+//	next := &omise.OccurrenceList{}
+//	if e := client.Follow(*schd.Occurrences.Location, next); e != nil {
+//		panic(e)
+//	}
+//
+func (c *Client) Follow(location string, result interface{}) error {
+	op := &internal.Op{
+		Method:    "GET",
+		Retryable: true,
+	}
+
+	if strings.HasPrefix(location, "/") {
+		op.Endpoint, op.Path = internal.API, location
+	} else {
+		for _, endpoint := range []internal.Endpoint{internal.API, internal.Vault} {
+			base := string(endpoint)
+			if ep, ok := c.Endpoints[endpoint]; ok {
+				base = ep
+			}
+
+			if strings.HasPrefix(location, base+"/") {
+				op.Endpoint, op.Path = endpoint, strings.TrimPrefix(location, base)
+				break
+			}
+		}
+	}
+
+	if op.Endpoint == "" {
+		return ErrForeignLocation
+	}
+
+	if i := strings.Index(op.Path, "?"); i >= 0 {
+		values, e := url.ParseQuery(op.Path[i+1:])
+		if e != nil {
+			return e
+		}
+
+		op.Path, op.Values = op.Path[:i], values
+	}
+
+	return c.Do(result, op)
+}
+
+func (c *Client) do(ctx context.Context, result interface{}, operation internal.Operation, header http.Header) error {
+	_, e := c.send(ctx, result, operation, header)
+	return e
+}
+
+// send performs a single attempt of the operation like do and also returns the headers of
+// the response, if one was received.
+func (c *Client) send(ctx context.Context, result interface{}, operation internal.Operation, header http.Header) (http.Header, error) {
+	if e := ctx.Err(); e != nil {
+		return nil, e
+	}
+
 	req, e := c.Request(operation)
 	if e != nil {
-		return e
+		return nil, e
+	}
+
+	req = req.WithContext(ctx)
+	setHeaders(req, header)
+
+	if c.BeforeSend != nil && hasPayload(req) {
+		if e := c.notifyBeforeSend(operation, req); e != nil {
+			return nil, e
+		}
+	}
+
+	if c.CompressRequests && hasPayload(req) {
+		if e := compressRequest(req); e != nil {
+			return nil, e
+		}
+	}
+
+	if sem := c.semaphore(); sem != nil {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	timeout := operation.Op().Timeout
+	if timeout <= 0 {
+		timeout = operationTimeout(ctx)
+	}
+
+	httpClient := c.Client
+	if timeout > 0 {
+		copied := *c.Client
+		copied.Timeout = timeout
+		httpClient = &copied
 	}
 
 	// response
-	resp, e := c.Client.Do(req)
+	resp, e := httpClient.Do(req)
 	if resp != nil {
 		defer resp.Body.Close()
 	}
 	if e != nil {
-		return e
+		return nil, newOperationError(operation, e)
 	}
 
+	c.recordRateLimit(resp.Header)
+
 	buffer, e := ioutil.ReadAll(resp.Body)
 	if e != nil {
-		return &ErrTransport{e, buffer}
+		return resp.Header, newOperationError(operation, &ErrTransport{e, buffer})
 	}
 
 	switch {
+	case resp.StatusCode == 304:
+		return resp.Header, ErrNotModified
 	case resp.StatusCode != 200:
 		err := &Error{StatusCode: resp.StatusCode}
-		if e := json.Unmarshal(buffer, err); e != nil {
-			return &ErrTransport{e, buffer}
+		if e := DecodeInto(buffer, err); e != nil {
+			return resp.Header, &DecodeError{resp.StatusCode, e, buffer}
+		}
+
+		// some failures are reported with the affected object instead of an error object
+		if result != nil && err.Object != "error" {
+			_ = DecodeInto(buffer, result)
 		}
 
-		return err
+		return resp.Header, err
 	} // status == 200 && e == nil
 
 	if c.debug {
@@ -223,10 +604,124 @@ func (c *Client) Do(result interface{}, operation internal.Operation) error {
 	}
 
 	if result != nil {
-		if e := json.Unmarshal(buffer, result); e != nil {
-			return &ErrTransport{e, buffer}
+		if e := DecodeInto(buffer, result); e != nil {
+			return resp.Header, &DecodeError{resp.StatusCode, e, buffer}
+		}
+	}
+
+	return resp.Header, nil
+}
+
+// idempotencyKey returns the Idempotency-Key a request sent with the given headers carries,
+// taking the client's DefaultHeaders into account.
+func (c *Client) idempotencyKey(header http.Header) string {
+	var key []string
+	for _, h := range []http.Header{c.DefaultHeaders, header} {
+		for name, values := range h {
+			if http.CanonicalHeaderKey(name) == "Idempotency-Key" {
+				key = values
+			}
+		}
+	}
+
+	if len(key) == 0 {
+		return ""
+	}
+
+	return key[0]
+}
+
+func (c *Client) baseContext() context.Context {
+	if c.BaseContext != nil {
+		return c.BaseContext
+	}
+
+	return context.Background()
+}
+
+func setHeaders(req *http.Request, header http.Header) {
+	for key, values := range header {
+		if http.CanonicalHeaderKey(key) == "Authorization" {
+			continue
+		}
+
+		req.Header.Del(key)
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+}
+
+func compressRequest(req *http.Request) error {
+	body, e := req.GetBody()
+	if e != nil {
+		return e
+	}
+	defer body.Close()
+
+	buffer := &bytes.Buffer{}
+	writer := gzip.NewWriter(buffer)
+	if _, e := io.Copy(writer, body); e != nil {
+		return e
+	}
+	if e := writer.Close(); e != nil {
+		return e
+	}
+
+	compressed := buffer.Bytes()
+	req.Body = ioutil.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	req.ContentLength = int64(len(compressed))
+	req.Header.Set("Content-Encoding", "gzip")
+	return nil
+}
+
+func (c *Client) semaphore() chan struct{} {
+	c.semOnce.Do(func() {
+		if c.MaxConcurrency > 0 {
+			c.sem = make(chan struct{}, c.MaxConcurrency)
 		}
+	})
+
+	return c.sem
+}
+
+// hasPayload reports whether req carries a body that is sent as a payload. List operations
+// marshal their parameters as a JSON body even though they are sent with GET.
+func hasPayload(req *http.Request) bool {
+	return req.GetBody != nil && req.Method != "GET" && req.Method != "HEAD"
+}
+
+func (c *Client) notifyBeforeSend(operation internal.Operation, req *http.Request) error {
+	body, e := req.GetBody()
+	if e != nil {
+		return e
 	}
+	defer body.Close()
 
+	buffer, e := ioutil.ReadAll(body)
+	if e != nil {
+		return e
+	}
+
+	c.BeforeSend(req.Context(), operation, buffer)
 	return nil
 }
+
+func newOperationError(operation internal.Operation, e error) *OperationError {
+	op := operation.Op()
+
+	typ := reflect.TypeOf(operation)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	return &OperationError{
+		Op:     typ.Name(),
+		Path:   op.Path,
+		Method: op.Method,
+		Err:    e,
+	}
+}
@@ -1,15 +1,25 @@
 package omise_test
 
 import (
+	"compress/gzip"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	. "github.com/omise/omise-go"
 	"github.com/omise/omise-go/internal"
 	"github.com/omise/omise-go/internal/testutil"
 	"github.com/omise/omise-go/operations"
+	"github.com/omise/omise-go/schedule"
 	r "github.com/stretchr/testify/require"
 )
 
@@ -41,6 +51,89 @@ func TestNewClient(t *testing.T) {
 	r.Equal(t, ErrInvalidKey, e)
 }
 
+type closeIdleTransport struct {
+	responseTransport
+	closed int
+}
+
+func (t *closeIdleTransport) CloseIdleConnections() {
+	t.closed++
+}
+
+func TestClient_Close(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	transport := &closeIdleTransport{responseTransport: responseTransport{200, `{"object":"account"}`}}
+	client.Transport = transport
+	r.NoError(t, client.Close())
+	r.Equal(t, 1, transport.closed)
+
+	r.NoError(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+
+	client.Transport = failingTransport{errors.New("unused")}
+	r.NoError(t, client.Close())
+}
+
+func TestClient_Transport(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	transport, ok := client.Transport.(*http.Transport)
+	r.True(t, ok, "default transport is not *http.Transport")
+	r.True(t, transport.ForceAttemptHTTP2)
+	r.Equal(t, DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	r.NotNil(t, transport.TLSClientConfig.RootCAs)
+}
+
+func TestNewClient_Options(t *testing.T) {
+	pkey, skey := testutil.Keys()
+	httpClient := &http.Client{Transport: responseTransport{200, `{"object":"account"}`}}
+
+	client, e := NewClient(pkey, skey,
+		WithHTTPClient(httpClient),
+		WithAPIVersion("2019-05-29"),
+		WithUserAgentSuffix("myapp/1.0"),
+		WithEndpoint(APIEndpoint, "https://api.example.com"),
+	)
+	r.NoError(t, e)
+	r.Equal(t, httpClient, client.Client)
+	r.Equal(t, "2019-05-29", client.APIVersion)
+
+	req, e := client.Request(&operations.RetrieveAccount{})
+	r.NoError(t, e)
+	r.Equal(t, "https://api.example.com/account", req.URL.String())
+	r.Equal(t, "2019-05-29", req.Header.Get("Omise-Version"))
+	r.True(t, strings.HasSuffix(req.Header.Get("User-Agent"), " myapp/1.0"))
+
+	r.NoError(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+}
+
+func TestNewClient_Sandbox(t *testing.T) {
+	_, e := NewClient("pkey_test_123", "skey_test_123", Sandbox())
+	r.NoError(t, e)
+
+	_, e = NewClient("pkey_test_123", "skey_live_123", Sandbox())
+	r.Equal(t, ErrLiveKey, e)
+
+	_, e = NewClient("pkey_live_123", "skey_live_123")
+	r.NoError(t, e)
+}
+
+func TestClient_RequireTestMode(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+	r.NoError(t, client.RequireTestMode())
+
+	client, e = NewClient("pkey_test_521w1g1t7w4x4rd22z0", "skey_live_521w1g1t6yh7sx4pu8n")
+	r.NoError(t, e)
+	r.Equal(t, ErrLiveKey, client.RequireTestMode())
+
+	client, e = NewClient("pkey_live_521w1g1t7w4x4rd22z0", "")
+	r.NoError(t, e)
+	r.Equal(t, ErrLiveKey, client.RequireTestMode())
+}
+
 func TestClient_Request(t *testing.T) {
 	pkey, skey := testutil.Keys()
 	client, e := NewClient(pkey, skey)
@@ -74,6 +167,17 @@ func TestClient_Request(t *testing.T) {
 	r.NoError(t, e)
 	r.Equal(t, "http://vault.omise.dev:4500/tokens", req.URL.String())
 
+	client.Endpoints[APIEndpoint] = "https://api.example.com"
+	client.Endpoints[VaultEndpoint] = "https://vault.example.com"
+
+	req, e = client.Request(&operations.RetrieveAccount{})
+	r.NoError(t, e)
+	r.Equal(t, "https://api.example.com/account", req.URL.String())
+
+	req, e = client.Request(&operations.CreateToken{})
+	r.NoError(t, e)
+	r.Equal(t, "https://vault.example.com/tokens", req.URL.String())
+
 	// general request properties
 	op := &operations.RetrieveAccount{}
 
@@ -91,6 +195,17 @@ func TestClient_Request(t *testing.T) {
 	r.Equal(t, req.Header.Get("Omise-Version"), "yadda")
 }
 
+func TestClient_FormatDate(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	tm := time.Date(2017, 5, 31, 18, 0, 0, 0, time.UTC)
+	r.Equal(t, "2017-05-31", client.FormatDate(tm))
+
+	client.Location = time.FixedZone("ICT", 7*60*60)
+	r.Equal(t, "2017-06-01", client.FormatDate(tm))
+}
+
 func TestClient_Error(t *testing.T) {
 	client, e := NewClient(testutil.Keys())
 	r.NoError(t, e)
@@ -115,6 +230,50 @@ func TestClient_Error(t *testing.T) {
 	r.IsType(t, ErrInternal(""), e)
 }
 
+func TestClient_ErrorFields(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+	client.Transport = responseTransport{404, `{
+		"object": "error",
+		"location": "https://www.omise.co/api-errors#not-found",
+		"code": "not_found",
+		"message": "account was not found"
+	}`}
+
+	e = client.Do(&Account{}, &operations.RetrieveAccount{})
+	err, ok := e.(*Error)
+	r.True(t, ok, "error returned is not *omise.Error.")
+	r.Equal(t, "error", err.Object)
+	r.Equal(t, "https://www.omise.co/api-errors#not-found", err.Location)
+	r.Equal(t, "not_found", err.Code)
+	r.Equal(t, "account was not found", err.Message)
+	r.Equal(t, 404, err.StatusCode)
+}
+
+func TestClient_ErrorWithObject(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+	client.Transport = responseTransport{400, `{
+		"object": "charge",
+		"id": "chrg_test_4yq7duw15p9hdrjp8oq",
+		"failure_code": "insufficient_fund"
+	}`}
+
+	charge := &Charge{}
+	e = client.Do(charge, &operations.RetrieveCharge{ChargeID: "chrg_test_4yq7duw15p9hdrjp8oq"})
+	err, ok := e.(*Error)
+	r.True(t, ok, "error returned is not *omise.Error.")
+	r.Equal(t, 400, err.StatusCode)
+	r.Equal(t, "chrg_test_4yq7duw15p9hdrjp8oq", charge.ID)
+	r.Equal(t, "insufficient_fund", *charge.FailureCode)
+
+	// error objects leave the result untouched
+	client.Transport = responseTransport{404, `{"object":"error","code":"not_found"}`}
+	charge = &Charge{}
+	r.Error(t, client.Do(charge, &operations.RetrieveCharge{ChargeID: "chrg_missing"}))
+	r.Empty(t, charge.Object)
+}
+
 func TestClient_TransportError(t *testing.T) {
 	client := testutil.NewFixedClient(t)
 
@@ -125,12 +284,446 @@ func TestClient_TransportError(t *testing.T) {
 	})
 	r.NotNil(t, e)
 
-	err, ok := e.(*ErrTransport)
-	r.True(t, ok, "error returned in not *omise.ErrTransport: ")
+	err, ok := e.(*DecodeError)
+	r.True(t, ok, "error returned in not *omise.DecodeError: ")
 
 	_, ok = err.Err.(*json.SyntaxError)
 	r.True(t, ok, "error does not wrap *json.SyntaxError")
+	r.Equal(t, 200, err.StatusCode)
 	r.Contains(t, string(err.Buffer), "not a valid JSON")
+	r.Contains(t, err.Error(), "with status 200 and "+strconv.Itoa(len(err.Buffer))+" bytes")
+}
+
+func TestClient_DecodeError_Truncated(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+	client.Transport = responseTransport{200, `{"object":"account","id":"acct_`}
+
+	e = client.Do(&Account{}, &operations.RetrieveAccount{})
+	err, ok := e.(*DecodeError)
+	r.True(t, ok, "error returned in not *omise.DecodeError: ")
+	r.Equal(t, 200, err.StatusCode)
+	r.Contains(t, err.Error(), "unexpected end of JSON input")
+	r.Contains(t, err.Error(), `{"object":"account","id":"acct_`)
+}
+
+type failingTransport struct {
+	err error
+}
+
+func (t failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
+func TestClient_OperationError(t *testing.T) {
+	rootErr := errors.New("connection reset by peer")
+
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+	client.Transport = failingTransport{rootErr}
+
+	e = client.Do(&Account{}, &operations.RetrieveAccount{})
+	r.NotNil(t, e)
+	r.True(t, errors.Is(e, rootErr), "error does not wrap the transport error")
+
+	var opErr *OperationError
+	r.True(t, errors.As(e, &opErr), "error returned is not *omise.OperationError")
+	r.Equal(t, "RetrieveAccount", opErr.Op)
+	r.Equal(t, "GET", opErr.Method)
+	r.Equal(t, "/account", opErr.Path)
+	r.Contains(t, opErr.Error(), "RetrieveAccount GET /account")
+}
+
+type responseTransport struct {
+	statusCode int
+	body       string
+}
+
+func (t responseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: t.statusCode,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(t.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestClient_NotModified(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	since := time.Date(2017, 5, 16, 7, 0, 0, 0, time.FixedZone("ICT", 7*60*60))
+	req, e := client.Request(&operations.ListSchedules{
+		List: operations.List{IfModifiedSince: since},
+	})
+	r.NoError(t, e)
+	r.Equal(t, "Tue, 16 May 2017 00:00:00 GMT", req.Header.Get("If-Modified-Since"))
+
+	req, e = client.Request(&operations.ListSchedules{})
+	r.NoError(t, e)
+	r.Empty(t, req.Header.Get("If-Modified-Since"))
+
+	client.Transport = responseTransport{304, ""}
+	schds := &ScheduleList{}
+	e = client.Do(schds, &operations.ListSchedules{
+		List: operations.List{IfModifiedSince: since},
+	})
+	r.Equal(t, ErrNotModified, e)
+	r.Empty(t, schds.Data)
+}
+
+func TestClient_BeforeSend(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+
+	var sent Operation
+	var body []byte
+	client.BeforeSend = func(ctx context.Context, op Operation, b []byte) {
+		sent, body = op, b
+	}
+
+	create := &operations.CreateChargeSchedule{
+		Every:     3,
+		Period:    schedule.PeriodDay,
+		StartDate: "2017-05-15",
+		EndDate:   "2018-05-15",
+		Customer:  "customer_id",
+		Amount:    100000,
+	}
+	client.MustDo(&Schedule{}, create)
+	r.Equal(t, create, sent)
+
+	expected, e := json.Marshal(create)
+	r.NoError(t, e)
+	r.Equal(t, string(expected), string(body))
+
+	body = nil
+	client.MustDo(&Account{}, &operations.RetrieveAccount{})
+	r.Nil(t, body)
+
+	// list operations carry a JSON body but are not mutating
+	sent = nil
+	client.MustDo(&ScheduleList{}, &operations.ListSchedules{})
+	r.Nil(t, sent)
+
+	// hooks may also be written against operations.Operation
+	var hook func(context.Context, operations.Operation, []byte) = func(ctx context.Context, op operations.Operation, b []byte) {
+		sent = op
+	}
+	client.BeforeSend = hook
+	client.MustDo(&Schedule{}, create)
+	r.Equal(t, create, sent)
+}
+
+type concurrencyTransport struct {
+	mutex    sync.Mutex
+	inFlight int
+	max      int
+}
+
+func (t *concurrencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mutex.Lock()
+	t.inFlight++
+	if t.inFlight > t.max {
+		t.max = t.inFlight
+	}
+	t.mutex.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	t.mutex.Lock()
+	t.inFlight--
+	t.mutex.Unlock()
+
+	return responseTransport{200, `{"object":"account"}`}.RoundTrip(req)
+}
+
+func TestClient_MaxConcurrency(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	transport := &concurrencyTransport{}
+	client.Transport = transport
+	client.MaxConcurrency = 2
+
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		go func() {
+			errs <- client.Do(&Account{}, &operations.RetrieveAccount{})
+		}()
+	}
+
+	for i := 0; i < 8; i++ {
+		r.NoError(t, <-errs)
+	}
+	r.True(t, transport.max <= 2, "more than 2 requests in flight: %d", transport.max)
+	r.True(t, transport.max > 0)
+}
+
+type flakyTransport struct {
+	failures int
+	attempts int
+	response responseTransport
+}
+
+func (t *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.attempts++
+	if t.attempts <= t.failures {
+		return nil, errors.New("connection reset by peer")
+	}
+
+	return t.response.RoundTrip(req)
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	// no policy, no retries
+	transport := &flakyTransport{failures: 1, response: responseTransport{200, `{"object":"account"}`}}
+	client.Transport = transport
+	r.Error(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+	r.Equal(t, 1, transport.attempts)
+
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}
+
+	// retryable operation
+	transport = &flakyTransport{failures: 2, response: responseTransport{200, `{"object":"account"}`}}
+	client.Transport = transport
+	r.NoError(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+	r.Equal(t, 3, transport.attempts)
+
+	// gives up after MaxRetries
+	transport = &flakyTransport{failures: 5, response: responseTransport{200, `{"object":"account"}`}}
+	client.Transport = transport
+	r.Error(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+	r.Equal(t, 3, transport.attempts)
+
+	// non-retryable operation
+	transport = &flakyTransport{failures: 1, response: responseTransport{200, `{"object":"charge"}`}}
+	client.Transport = transport
+	r.Error(t, client.Do(&Charge{}, &operations.CreateCharge{}))
+	r.Equal(t, 1, transport.attempts)
+
+	// retries server errors but not client errors
+	transport = &flakyTransport{response: responseTransport{503, `{"object":"error","code":"service_unavailable"}`}}
+	client.Transport = transport
+	r.Error(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+	r.Equal(t, 3, transport.attempts)
+
+	transport = &flakyTransport{response: responseTransport{404, `{"object":"error","code":"not_found"}`}}
+	client.Transport = transport
+	r.Error(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+	r.Equal(t, 1, transport.attempts)
+
+	// non-retryable operation sent with an idempotency key
+	header := http.Header{}
+	header.Set("Idempotency-Key", "charge-123")
+
+	transport = &flakyTransport{failures: 1, response: responseTransport{200, `{"object":"charge"}`}}
+	client.Transport = transport
+	r.NoError(t, client.DoWithHeader(&Charge{}, &operations.CreateCharge{}, header))
+	r.Equal(t, 2, transport.attempts)
+
+	// or with an idempotency key among the client's default headers
+	client.DefaultHeaders = header
+
+	transport = &flakyTransport{failures: 1, response: responseTransport{200, `{"object":"charge"}`}}
+	client.Transport = transport
+	r.NoError(t, client.Do(&Charge{}, &operations.CreateCharge{}))
+	r.Equal(t, 2, transport.attempts)
+}
+
+func TestClient_RetryPolicy_MaxElapsed(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	// stops before a backoff that would exceed the budget
+	transport := &flakyTransport{failures: 5, response: responseTransport{200, `{"object":"account"}`}}
+	client.Transport = transport
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 5, Backoff: 10 * time.Millisecond, MaxElapsed: 25 * time.Millisecond}
+
+	r.Error(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+	r.Equal(t, 2, transport.attempts)
+
+	// aborts attempts in flight when the budget runs out
+	client.Transport = slowTransport{time.Hour}
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 5, Backoff: time.Millisecond, MaxElapsed: 10 * time.Millisecond}
+
+	e = client.Do(&Account{}, &operations.RetrieveAccount{})
+	r.True(t, errors.Is(e, context.DeadlineExceeded))
+}
+
+type headerTransport struct {
+	header http.Header
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.header = req.Header
+	return responseTransport{200, `{"object":"account"}`}.RoundTrip(req)
+}
+
+func TestClient_DoWithContext(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+	client.Transport = responseTransport{200, `{"object":"account"}`}
+
+	account := &Account{}
+	r.NoError(t, client.DoWithContext(context.Background(), account, &operations.RetrieveAccount{}))
+	r.Equal(t, "account", account.Object)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	e = client.DoWithContext(ctx, &Account{}, &operations.RetrieveAccount{})
+	r.True(t, errors.Is(e, context.Canceled))
+
+	// pending retries are abandoned
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	transport := &flakyTransport{failures: 5, response: responseTransport{200, `{"object":"account"}`}}
+	client.Transport = transport
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 5, Backoff: time.Hour}
+	e = client.DoWithContext(ctx, &Account{}, &operations.RetrieveAccount{})
+	r.True(t, errors.Is(e, context.DeadlineExceeded))
+	r.Equal(t, 1, transport.attempts)
+}
+
+func TestClient_DoWithContext_MaxConcurrency(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+	client.Transport = &concurrencyTransport{}
+	client.MaxConcurrency = 1
+
+	done := make(chan error)
+	go func() {
+		done <- client.Do(&Account{}, &operations.RetrieveAccount{})
+	}()
+	time.Sleep(2 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	e = client.DoWithContext(ctx, &Account{}, &operations.RetrieveAccount{})
+	r.True(t, errors.Is(e, context.DeadlineExceeded))
+	r.NoError(t, <-done)
+}
+
+type slowTransport struct {
+	delay time.Duration
+}
+
+func (t slowTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case <-time.After(t.delay):
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	return responseTransport{200, `{"object":"account"}`}.RoundTrip(req)
+}
+
+func TestClient_OperationTimeout(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+	client.Transport = slowTransport{50 * time.Millisecond}
+
+	op := &internal.Op{
+		Endpoint: internal.API,
+		Method:   "GET",
+		Path:     "/account",
+		Timeout:  5 * time.Millisecond,
+	}
+	e = client.Do(&Account{}, op)
+	r.Error(t, e)
+	r.IsType(t, &OperationError{}, e)
+
+	// overrides the client's own timeout
+	client.Timeout = 5 * time.Millisecond
+	op.Timeout = time.Second
+	r.NoError(t, client.Do(&Account{}, op))
+	r.Error(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+
+	// any operation can be given a timeout through the context
+	ctx := WithOperationTimeout(context.Background(), time.Second)
+	r.NoError(t, client.DoWithContext(ctx, &Account{}, &operations.RetrieveAccount{}))
+
+	client.Timeout = 0
+	ctx = WithOperationTimeout(context.Background(), 5*time.Millisecond)
+	e = client.DoWithContext(ctx, &Account{}, &operations.RetrieveAccount{})
+	r.Error(t, e)
+	r.IsType(t, &OperationError{}, e)
+}
+
+type recordingTransport struct {
+	responseTransport
+	urls []string
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.urls = append(t.urls, req.URL.String())
+	return t.responseTransport.RoundTrip(req)
+}
+
+func TestClient_Follow(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	transport := &recordingTransport{responseTransport: responseTransport{200, `{"object":"list","location":"/schedules"}`}}
+	client.Transport = transport
+
+	schds := &ScheduleList{}
+	r.NoError(t, client.Follow("/schedules/schd_1/occurrences?limit=10", schds))
+	r.Equal(t, "/schedules", *schds.Location)
+
+	r.NoError(t, client.Follow("https://api.omise.co/schedules", &ScheduleList{}))
+
+	client.Endpoints[APIEndpoint] = "https://api.example.com"
+	r.NoError(t, client.Follow("https://api.example.com/charges", &ChargeList{}))
+
+	r.Equal(t, []string{
+		"https://api.omise.co/schedules/schd_1/occurrences?limit=10",
+		"https://api.omise.co/schedules",
+		"https://api.example.com/charges",
+	}, transport.urls)
+
+	for _, location := range []string{
+		"https://api.omise.co/charges",
+		"https://evil.example.com/charges",
+		"https://api.example.com.evil.com/charges",
+		"schedules",
+	} {
+		r.Equal(t, ErrForeignLocation, client.Follow(location, &ChargeList{}), location)
+	}
+	r.Len(t, transport.urls, 3)
+}
+
+func TestClient_Headers(t *testing.T) {
+	pkey, skey := testutil.Keys()
+	client, e := NewClient(pkey, skey)
+	r.NoError(t, e)
+
+	transport := &headerTransport{}
+	client.Transport = transport
+	client.DefaultHeaders = http.Header{}
+	client.DefaultHeaders.Set("X-Tenant", "tenant-1")
+	client.DefaultHeaders.Set("X-Trace-ID", "default")
+	client.DefaultHeaders.Set("Authorization", "Bearer nope")
+
+	r.NoError(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+	r.Equal(t, "tenant-1", transport.header.Get("X-Tenant"))
+	r.Equal(t, "default", transport.header.Get("X-Trace-ID"))
+	r.Contains(t, transport.header.Get("User-Agent"), "OmiseGo/")
+
+	header := http.Header{}
+	header.Set("X-Trace-ID", "trace-123")
+	r.NoError(t, client.DoWithHeader(&Account{}, &operations.RetrieveAccount{}, header))
+	r.Equal(t, "tenant-1", transport.header.Get("X-Tenant"))
+	r.Equal(t, []string{"trace-123"}, transport.header["X-Trace-Id"])
+
+	req, e := client.Request(&operations.RetrieveAccount{})
+	r.NoError(t, e)
+	user, _, ok := req.BasicAuth()
+	r.True(t, ok)
+	r.Equal(t, skey, user)
 }
 
 func ExampleClient_Do() {
@@ -162,3 +755,202 @@ func ExampleClient_Do() {
 	// verify
 	fmt.Printf("authorized charge: %#v\n", charge)
 }
+
+type dateTransport struct {
+	date string
+	body string
+}
+
+func (t dateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	status, body := 200, `{"object":"account"}`
+	if t.body != "" {
+		status, body = 401, t.body
+	}
+
+	resp, e := responseTransport{status, body}.RoundTrip(req)
+	if t.date != "" {
+		resp.Header.Set("Date", t.date)
+	}
+
+	return resp, e
+}
+
+func TestClient_ServerNow(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	client.Transport = dateTransport{date: "Tue, 16 May 2017 07:00:00 GMT"}
+	now, e := client.ServerNow()
+	r.NoError(t, e)
+	r.True(t, time.Date(2017, 5, 16, 7, 0, 0, 0, time.UTC).Equal(now))
+
+	client.Transport = dateTransport{}
+	_, e = client.ServerNow()
+	r.Equal(t, ErrNoServerTime, e)
+
+	// error responses carry a Date header too but are not a server time
+	client.Transport = dateTransport{
+		date: "Tue, 16 May 2017 07:00:00 GMT",
+		body: `{"object":"error","code":"authentication_failure","message":"authentication failed"}`,
+	}
+	_, e = client.ServerNow()
+	err, ok := e.(*Error)
+	r.True(t, ok)
+	r.Equal(t, 401, err.StatusCode)
+	r.Equal(t, "authentication_failure", err.Code)
+
+	// goes through the MaxConcurrency limit and records rate limits
+	client.Transport = rateLimitTransport{"42"}
+	_, e = client.ServerNow()
+	r.Equal(t, ErrNoServerTime, e)
+	limit, ok := client.LastRateLimit()
+	r.True(t, ok)
+	r.Equal(t, 42, limit.Remaining)
+}
+
+type correlationTransport struct {
+	id string
+}
+
+func (t *correlationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.id, _ = CorrelationID(req.Context())
+	return responseTransport{200, `{"object":"account"}`}.RoundTrip(req)
+}
+
+func TestClient_CorrelationID(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	transport := &correlationTransport{}
+	client.Transport = transport
+
+	ctx := WithCorrelationID(context.Background(), "trace-123")
+	r.NoError(t, client.DoWithContext(ctx, &Account{}, &operations.RetrieveAccount{}))
+	r.Equal(t, "trace-123", transport.id)
+
+	var hooked string
+	client.BeforeSend = func(ctx context.Context, op Operation, body []byte) {
+		hooked, _ = CorrelationID(ctx)
+	}
+
+	update := &operations.UpdateCustomer{CustomerID: "cust_test_4yq6txdpfadhbaqnwp3", Email: "john@example.com"}
+	r.NoError(t, client.DoWithContext(ctx, &Account{}, update))
+	r.Equal(t, "trace-123", hooked)
+
+	_, ok := CorrelationID(context.Background())
+	r.False(t, ok)
+}
+
+type gzipTransport struct {
+	encoding string
+	body     string
+}
+
+func (t *gzipTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.encoding = req.Header.Get("Content-Encoding")
+
+	reader, e := gzip.NewReader(req.Body)
+	if e != nil {
+		return nil, e
+	}
+
+	body, e := ioutil.ReadAll(reader)
+	if e != nil {
+		return nil, e
+	}
+
+	t.body = string(body)
+	return responseTransport{200, `{"object":"customer"}`}.RoundTrip(req)
+}
+
+func TestClient_CompressRequests(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	transport := &gzipTransport{}
+	client.Transport = transport
+	client.CompressRequests = true
+
+	var sent []byte
+	client.BeforeSend = func(ctx context.Context, operation Operation, body []byte) {
+		sent = body
+	}
+
+	update := &operations.UpdateCustomer{CustomerID: "cust_test_4yq6txdpfadhbaqnwp3", Email: "john@example.com"}
+	r.NoError(t, client.Do(&Customer{}, update))
+	r.Equal(t, "gzip", transport.encoding)
+	r.Equal(t, "email=john%40example.com", transport.body)
+	r.Equal(t, transport.body, string(sent))
+
+	// requests without a body are left alone
+	headers := &headerTransport{}
+	client.Transport = headers
+	r.NoError(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+	r.Empty(t, headers.header.Get("Content-Encoding"))
+
+	// so are list requests, even though they carry a JSON body
+	headers = &headerTransport{}
+	client.Transport = headers
+	r.NoError(t, client.Do(&ScheduleList{}, &operations.ListSchedules{}))
+	r.Empty(t, headers.header.Get("Content-Encoding"))
+}
+
+type rateLimitTransport struct {
+	remaining string
+}
+
+func (t rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, e := responseTransport{200, `{"object":"account"}`}.RoundTrip(req)
+	if t.remaining != "" {
+		resp.Header.Set("X-RateLimit-Limit", "100")
+		resp.Header.Set("X-RateLimit-Remaining", t.remaining)
+		resp.Header.Set("X-RateLimit-Reset", "1494918000")
+	}
+
+	return resp, e
+}
+
+func TestClient_LastRateLimit(t *testing.T) {
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+
+	_, ok := client.LastRateLimit()
+	r.False(t, ok)
+
+	client.Transport = rateLimitTransport{"42"}
+	r.NoError(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+
+	limit, ok := client.LastRateLimit()
+	r.True(t, ok)
+	r.Equal(t, 100, limit.Limit)
+	r.Equal(t, 42, limit.Remaining)
+	r.True(t, time.Date(2017, 5, 16, 7, 0, 0, 0, time.UTC).Equal(limit.Reset))
+
+	// responses without the headers keep the last known budget
+	client.Transport = rateLimitTransport{}
+	r.NoError(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+
+	limit, ok = client.LastRateLimit()
+	r.True(t, ok)
+	r.Equal(t, 42, limit.Remaining)
+}
+
+func TestClient_BaseContext(t *testing.T) {
+	base, cancel := context.WithCancel(context.Background())
+	pkey, skey := testutil.Keys()
+	client, e := NewClient(pkey, skey, WithBaseContext(base))
+	r.NoError(t, e)
+	client.Transport = slowTransport{time.Hour}
+
+	time.AfterFunc(10*time.Millisecond, cancel)
+	e = client.Do(&Account{}, &operations.RetrieveAccount{})
+	r.True(t, errors.Is(e, context.Canceled))
+
+	// contexts given to DoWithContext are aborted as well
+	e = client.DoWithContext(context.Background(), &Account{}, &operations.RetrieveAccount{})
+	r.True(t, errors.Is(e, context.Canceled))
+
+	client.BaseContext = context.Background()
+	client.Transport = responseTransport{200, `{"object":"account"}`}
+	r.NoError(t, client.Do(&Account{}, &operations.RetrieveAccount{}))
+}
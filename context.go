@@ -0,0 +1,63 @@
+package omise
+
+import (
+	"context"
+	"time"
+)
+
+type correlationIDKey struct{}
+
+type operationTimeoutKey struct{}
+
+// WithCorrelationID returns a copy of ctx carrying the given correlation ID. Requests sent
+// with DoWithContext are bound to the context, so the ID can be read back with
+// CorrelationID from the context passed to Client.BeforeSend, or from the request in the
+// client's Transport.
+//
+// Example:
+//
+//	ctx := omise.WithCorrelationID(req.Context(), traceID)
+//	if e := client.DoWithContext(ctx, charge, retrieve); e != nil {
+//		panic(e)
+//	}
+//
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationID returns the correlation ID stored in ctx by WithCorrelationID. The second
+// return value is false if there is none.
+//
+// Example:
+//
+//	client.BeforeSend = func(ctx context.Context, op omise.Operation, body []byte) {
+//		id, _ := omise.CorrelationID(ctx)
+//		log.Println(id, op.Op().Method, op.Op().Path)
+//	}
+//
+func CorrelationID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(correlationIDKey{}).(string)
+	return id, ok
+}
+
+// WithOperationTimeout returns a copy of ctx that limits each attempt of an operation sent
+// with DoWithContext to timeout, in place of the client's Timeout. Unlike
+// context.WithTimeout, the limit applies to every attempt separately, so retries are not
+// cut short. It lets any operation override the client's Timeout; a Timeout set on a list
+// operation takes precedence.
+//
+// Example:
+//
+//	ctx := omise.WithOperationTimeout(context.Background(), time.Minute)
+//	if e := client.DoWithContext(ctx, charge, create); e != nil {
+//		panic(e)
+//	}
+//
+func WithOperationTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, operationTimeoutKey{}, timeout)
+}
+
+func operationTimeout(ctx context.Context) time.Duration {
+	timeout, _ := ctx.Value(operationTimeoutKey{}).(time.Duration)
+	return timeout
+}
@@ -0,0 +1,45 @@
+package omise_test
+
+import (
+	"testing"
+
+	. "github.com/omise/omise-go"
+	"github.com/omise/omise-go/schedule"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestToMajorUnits(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		currency string
+		major    float64
+	}{
+		{100000, "thb", 1000},
+		{100050, "THB", 1000.5},
+		{100000, "jpy", 100000},
+		{1234, "bhd", 1.234},
+	}
+
+	for _, test := range tests {
+		major, e := ToMajorUnits(test.amount, test.currency)
+		r.NoError(t, e)
+		r.Equal(t, test.major, major, test.currency)
+	}
+
+	_, e := ToMajorUnits(100, "xyz")
+	r.Equal(t, ErrInvalidCurrency("xyz"), e)
+}
+
+func TestSchedule_ChargeAmountMajor(t *testing.T) {
+	schd := &Schedule{Charge: &schedule.ChargeDetail{Amount: 5000, Currency: "jpy"}}
+	major, ok := schd.ChargeAmountMajor()
+	r.True(t, ok)
+	r.Equal(t, 5000.0, major)
+
+	schd.Charge.Currency = ""
+	_, ok = schd.ChargeAmountMajor()
+	r.False(t, ok)
+
+	_, ok = (&Schedule{}).ChargeAmountMajor()
+	r.False(t, ok)
+}
@@ -8,4 +8,5 @@ type Customer struct {
 	Email       string    `json:"email" pretty:""`
 	Description string    `json:"description" pretty:""`
 	Cards       *CardList `json:"cards"`
+	Metadata    Metadata  `json:"metadata"`
 }
@@ -0,0 +1,20 @@
+package omise
+
+import "encoding/json"
+
+// Marshal and Unmarshal are used by Client to encode request bodies and decode responses,
+// and by the webhook handler to decode events. They default to the encoding/json
+// functions and may be replaced, before any client is used, with compatible
+// implementations such as those of jsoniter. Custom MarshalJSON and UnmarshalJSON methods
+// on the types in this package still use encoding/json internally.
+var (
+	Marshal   = json.Marshal
+	Unmarshal = json.Unmarshal
+)
+
+// DecodeInto decodes a raw JSON object returned by Omise's REST API into target, using
+// the same rules that Client.Do uses to decode responses. This is useful for decoding
+// elements of a generic payload, such as search results, into a specific type.
+func DecodeInto(raw json.RawMessage, target interface{}) error {
+	return Unmarshal(raw, target)
+}
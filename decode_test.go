@@ -0,0 +1,64 @@
+package omise_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/omise/omise-go"
+	"github.com/omise/omise-go/internal/testutil"
+	"github.com/omise/omise-go/operations"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestDecodeInto(t *testing.T) {
+	result := &struct {
+		Data []json.RawMessage `json:"data"`
+	}{}
+	e := json.Unmarshal([]byte(`{
+		"data": [
+			{"object": "schedule", "id": "schd_57z9hj228pusa652nk1", "start_date": "2017-05-15"},
+			{"object": "charge", "id": "chrg_test_4yq7duw15p9hdrjp8oq", "amount": 100000}
+		]
+	}`), result)
+	r.NoError(t, e)
+
+	schd := &Schedule{}
+	r.NoError(t, DecodeInto(result.Data[0], schd))
+	r.Equal(t, "schd_57z9hj228pusa652nk1", schd.ID)
+	r.Equal(t, time.Date(2017, 5, 15, 0, 0, 0, 0, time.UTC), time.Time(schd.StartDate))
+
+	charge := &Charge{}
+	r.NoError(t, DecodeInto(result.Data[1], charge))
+	r.Equal(t, Amount(100000), charge.Amount)
+
+	r.Error(t, DecodeInto(json.RawMessage(`{"id":`), charge))
+}
+
+func TestMarshalUnmarshal_Override(t *testing.T) {
+	marshal, unmarshal := Marshal, Unmarshal
+	defer func() { Marshal, Unmarshal = marshal, unmarshal }()
+
+	marshals, unmarshals := 0, 0
+	Marshal = func(v interface{}) ([]byte, error) {
+		marshals++
+		return marshal(v)
+	}
+	Unmarshal = func(data []byte, v interface{}) error {
+		unmarshals++
+		return unmarshal(data, v)
+	}
+
+	client, e := NewClient(testutil.Keys())
+	r.NoError(t, e)
+	client.Transport = responseTransport{200, `{"object":"list","data":[]}`}
+
+	schds := &ScheduleList{}
+	r.NoError(t, client.Do(schds, &operations.ListSchedules{}))
+	r.Equal(t, 1, marshals)
+	r.Equal(t, 1, unmarshals)
+	r.Equal(t, "list", schds.Object)
+
+	r.NoError(t, DecodeInto(json.RawMessage(`{"object":"charge"}`), &Charge{}))
+	r.Equal(t, 2, unmarshals)
+}
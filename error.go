@@ -8,6 +8,21 @@ import (
 // ErrInvalidKey represents missing or bad API key errors.
 var ErrInvalidKey = errors.New("invalid public or secret key")
 
+// ErrLiveKey is returned by Client.RequireTestMode when the client is configured with a
+// live key.
+var ErrLiveKey = errors.New("live key specified where a test key is required")
+
+// ErrNotModified is returned when a conditional request is answered with 304 Not Modified.
+var ErrNotModified = errors.New("not modified")
+
+// ErrForeignLocation is returned by Client.Follow when the location does not point to one
+// of the client's Omise endpoints.
+var ErrForeignLocation = errors.New("location is not an Omise endpoint")
+
+// ErrNoServerTime is returned by Client.ServerNow when Omise's response carries no valid
+// Date header.
+var ErrNoServerTime = errors.New("response has no valid Date header")
+
 // ErrInternal represents internal library error. If you encounter this, it is mostly
 // likely due to a bug in the omise-go library itself. Please report it by opening a new
 // GitHub issue or contacting support.
@@ -28,10 +43,63 @@ func (e ErrTransport) Error() string {
 		"\n with response body: " + string(e.Buffer)
 }
 
+// Unwrap returns the underlying error so it can be inspected with errors.Is and errors.As.
+func (e ErrTransport) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError is returned when a response body from Omise's REST API cannot be decoded.
+// It records the HTTP status code and the raw response body so that a truncated response
+// (e.g. a dropped connection) can be told apart from a malformed one.
+type DecodeError struct {
+	StatusCode int
+	Err        error
+	Buffer     []byte
+}
+
+const decodeErrorSnippetLength = 256
+
+func (e *DecodeError) Error() string {
+	snippet := e.Buffer
+	if len(snippet) > decodeErrorSnippetLength {
+		snippet = snippet[:decodeErrorSnippetLength]
+	}
+
+	return "decode error: " + e.Err.Error() +
+		"\n with status " + strconv.Itoa(e.StatusCode) +
+		" and " + strconv.Itoa(len(e.Buffer)) + " bytes of response body: " + string(snippet)
+}
+
+// Unwrap returns the underlying error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// OperationError wraps errors that occurred while sending an operation to Omise's REST API
+// or while reading its response. Op contains the name of the operation type (e.g.
+// "RetrieveSchedule") while Path and Method describe the HTTP request that was attempted.
+// The root cause is available via errors.Is and errors.As or the Err field.
+type OperationError struct {
+	Op     string
+	Path   string
+	Method string
+	Err    error
+}
+
+func (e *OperationError) Error() string {
+	return e.Op + " " + e.Method + " " + e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
 // Error struct represents errors that may be returned from Omise's REST API. You can use
 // the Code or the HTTP StatusCode field to test for the exact error condition in your
-// code.
+// code. Location links to the Omise documentation describing the error.
 type Error struct {
+	Object     string `json:"object"`
 	Location   string `json:"location"`
 	StatusCode int    `json:"status"`
 	Code       string `json:"code"`
@@ -0,0 +1,80 @@
+package omise
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ToICal renders the schedule's NextOccurrences as an iCalendar (RFC 5545) document with
+// one all-day VEVENT per occurrence, suitable for publishing as a billing calendar. The
+// InWords description is used as the event summary. Lines longer than 75 octets are
+// folded as required by RFC 5545.
+func (s *Schedule) ToICal() string {
+	summary := s.InWords
+	if summary == "" {
+		summary = "Schedule " + s.ID
+	}
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Omise//omise-go//EN",
+	}
+
+	stamp := s.Created.UTC().Format("20060102T150405Z")
+	for _, date := range s.NextOccurrences {
+		day := time.Time(date).Format("20060102")
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+s.ID+"-"+day,
+			"DTSTAMP:"+stamp,
+			"DTSTART;VALUE=DATE:"+day,
+			"SUMMARY:"+escapeICalText(summary),
+			"END:VEVENT",
+		)
+	}
+
+	lines = append(lines, "END:VCALENDAR")
+
+	builder := &strings.Builder{}
+	for _, line := range lines {
+		builder.WriteString(foldICalLine(line))
+		builder.WriteString("\r\n")
+	}
+
+	return builder.String()
+}
+
+// maxICalLineLength is the maximum length of a content line in octets, excluding the line
+// break, per RFC 5545 section 3.1.
+const maxICalLineLength = 75
+
+// foldICalLine splits line into content lines of at most 75 octets, each continuation line
+// starting with a single space. Lines are never split inside a UTF-8 sequence.
+func foldICalLine(line string) string {
+	builder, length := &strings.Builder{}, 0
+	for _, char := range line {
+		size := utf8.RuneLen(char)
+		if length+size > maxICalLineLength {
+			builder.WriteString("\r\n ")
+			length = 1
+		}
+
+		builder.WriteRune(char)
+		length += size
+	}
+
+	return builder.String()
+}
+
+var iCalTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\n", `\n`,
+)
+
+func escapeICalText(text string) string {
+	return iCalTextEscaper.Replace(text)
+}
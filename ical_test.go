@@ -0,0 +1,61 @@
+package omise_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	. "github.com/omise/omise-go"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestSchedule_ToICal(t *testing.T) {
+	schd := &Schedule{
+		Base: Base{
+			ID:      "schd_57z9hj228pusa652nk1",
+			Created: time.Date(2017, 5, 15, 17, 35, 1, 0, time.UTC),
+		},
+		InWords: "Every 3 weeks on Monday, Saturday",
+		NextOccurrences: []Date{
+			Date(time.Date(2017, 5, 15, 0, 0, 0, 0, time.UTC)),
+			Date(time.Date(2017, 5, 20, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	r.Equal(t, "BEGIN:VCALENDAR\r\n"+
+		"VERSION:2.0\r\n"+
+		"PRODID:-//Omise//omise-go//EN\r\n"+
+		"BEGIN:VEVENT\r\n"+
+		"UID:schd_57z9hj228pusa652nk1-20170515\r\n"+
+		"DTSTAMP:20170515T173501Z\r\n"+
+		"DTSTART;VALUE=DATE:20170515\r\n"+
+		"SUMMARY:Every 3 weeks on Monday\\, Saturday\r\n"+
+		"END:VEVENT\r\n"+
+		"BEGIN:VEVENT\r\n"+
+		"UID:schd_57z9hj228pusa652nk1-20170520\r\n"+
+		"DTSTAMP:20170515T173501Z\r\n"+
+		"DTSTART;VALUE=DATE:20170520\r\n"+
+		"SUMMARY:Every 3 weeks on Monday\\, Saturday\r\n"+
+		"END:VEVENT\r\n"+
+		"END:VCALENDAR\r\n", schd.ToICal())
+}
+
+func TestSchedule_ToICal_Folding(t *testing.T) {
+	schd := &Schedule{
+		Base:            Base{ID: "schd_57z9hj228pusa652nk1"},
+		InWords:         "Every 3 weeks on Monday, Tuesday, Wednesday, Thursday, Friday, Saturday and Sunday – ค่าสมาชิก",
+		NextOccurrences: []Date{Date(time.Date(2017, 5, 15, 0, 0, 0, 0, time.UTC))},
+	}
+
+	ical := schd.ToICal()
+	r.Contains(t, ical, "\r\n ")
+	for _, line := range strings.Split(strings.TrimSuffix(ical, "\r\n"), "\r\n") {
+		r.True(t, len(line) <= 75, line)
+		r.True(t, utf8.ValidString(line), line)
+	}
+
+	unfolded := strings.Replace(ical, "\r\n ", "", -1)
+	r.Contains(t, unfolded, "SUMMARY:Every 3 weeks on Monday\\, Tuesday\\, Wednesday\\, Thursday\\, "+
+		"Friday\\, Saturday and Sunday – ค่าสมาชิก\r\n")
+}
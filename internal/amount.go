@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Amount is the tolerant amount type exposed as omise.Amount. It lives here so that the
+// schedule package, which omise depends on, can use it too.
+type Amount int64
+
+// UnmarshalJSON decodes either a JSON number or a string holding an integer.
+func (a *Amount) UnmarshalJSON(buffer []byte) error {
+	if len(buffer) > 0 && buffer[0] == '"' {
+		var s string
+		if e := json.Unmarshal(buffer, &s); e != nil {
+			return e
+		}
+
+		v, e := strconv.ParseInt(s, 10, 64)
+		if e != nil {
+			return e
+		}
+
+		*a = Amount(v)
+		return nil
+	}
+
+	return json.Unmarshal(buffer, (*int64)(a))
+}
@@ -10,6 +10,7 @@ import (
 var Context = &struct {
 	Models           []string
 	SearchableModels []string
+	ListOperations   []string
 }{
 	Models: []string{
 		"Account",
@@ -40,6 +41,21 @@ var Context = &struct {
 		"Transfer",
 		"Link",
 	},
+	ListOperations: []string{
+		"ListCards",
+		"ListCharges",
+		"ListCustomerSchedules",
+		"ListCustomers",
+		"ListDisputes",
+		"ListEvents",
+		"ListLinks",
+		"ListRecipients",
+		"ListRefunds",
+		"ListScheduleOccurrences",
+		"ListSchedules",
+		"ListTransactions",
+		"ListTransfers",
+	},
 }
 
 func main() {
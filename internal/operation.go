@@ -2,6 +2,7 @@ package internal
 
 import (
 	"net/url"
+	"time"
 )
 
 type Op struct {
@@ -12,6 +13,14 @@ type Op struct {
 	Values      url.Values `query:"-"`
 	Multipart   bool       `query:"-"`
 	ContentType string     `query:"-"`
+
+	// Retryable marks operations that are safe to be retried on transient failures.
+	Retryable bool `query:"-"`
+
+	// Timeout, if non-zero, limits the time taken by each attempt of the operation in
+	// place of the client's own Timeout. Only list operations set it; others are given a
+	// timeout through omise.WithOperationTimeout.
+	Timeout time.Duration `query:"-"`
 }
 
 // Op implements Operation.Op and allows the struct itself be passed as an Operation
@@ -23,3 +32,15 @@ func (op *Op) Op() *Op {
 type Operation interface {
 	Op() *Op
 }
+
+// Conditional is implemented by operations that may be sent as conditional requests.
+// A non-zero ModifiedSince time is sent in the If-Modified-Since header.
+type Conditional interface {
+	ModifiedSince() time.Time
+}
+
+// Validator is implemented by operations that check their parameters before a request is
+// built. A non-nil error aborts the operation.
+type Validator interface {
+	Validate() error
+}
@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -49,3 +50,7 @@ func newTestClient(t *testing.T, record, fixed bool) *TestClient {
 func (tc *TestClient) MustDo(result interface{}, op internal.Operation) {
 	r.NoError(tc, tc.Client.Do(result, op))
 }
+
+func (tc *TestClient) MustDoWithContext(ctx context.Context, result interface{}, op internal.Operation) {
+	r.NoError(tc, tc.Client.DoWithContext(ctx, result, op))
+}
@@ -4,10 +4,10 @@ package omise
 // See https://www.omise.co/links-api for more information.
 type Link struct {
 	Base
-	Amount     int64  `json:"amount"`
+	Amount     Amount `json:"amount"`
 	Currency   string `json:"currency"`
 	Used       bool   `json:"used"`
-	Multiple   bool   `json:"multiple"`
+	Multiple   Bool   `json:"multiple"`
 	PaymentURI string `json:"payment_uri"`
 
 	Title       string     `json:"title"`
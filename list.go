@@ -6,6 +6,9 @@ package omise
 // List structure contains fields that are common to list objects returned by the Omise's
 // REST API. See the pagination and lists documentation at
 // https://www.omise.co/api-pagination for more information.
+//
+// The From, To and Order fields echo back the filters the server actually applied, which
+// is useful for confirming how the request parameters were interpreted.
 type List struct {
 	Base
 	From string `json:"from"`
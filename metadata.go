@@ -0,0 +1,57 @@
+package omise
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+)
+
+// Metadata represents the arbitrary key-value data attached to Omise's objects. Values
+// decoded from JSON keep their exact representation: numbers are decoded as json.Number,
+// at any depth, so that they round-trip without losing precision, while nested objects and
+// arrays are decoded as map[string]interface{} and []interface{}. Use GetString and GetInt
+// to read values without type assertions.
+type Metadata map[string]interface{}
+
+// UnmarshalJSON decodes the metadata, keeping numbers as json.Number.
+func (m *Metadata) UnmarshalJSON(buffer []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(buffer))
+	decoder.UseNumber()
+
+	var values map[string]interface{}
+	if e := decoder.Decode(&values); e != nil {
+		return e
+	}
+
+	*m = values
+	return nil
+}
+
+// GetString returns the string value stored under key. The second return value is false
+// if the key is missing or does not hold a string.
+func (m Metadata) GetString(key string) (string, bool) {
+	s, ok := m[key].(string)
+	return s, ok
+}
+
+// GetInt returns the integer value stored under key. JSON numbers decoded as float64 or
+// json.Number are converted as long as they hold a whole number. The second return value
+// is false if the key is missing or does not hold an integer.
+func (m Metadata) GetInt(key string) (int64, bool) {
+	switch v := m[key].(type) {
+	case int:
+		return int64(v), true
+	case int64:
+		return v, true
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(v), true
+	case json.Number:
+		n, e := v.Int64()
+		return n, e == nil
+	}
+
+	return 0, false
+}
@@ -0,0 +1,84 @@
+package omise_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/omise/omise-go"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestMetadata(t *testing.T) {
+	charge := &Charge{}
+	e := json.Unmarshal([]byte(`{
+		"object": "charge",
+		"metadata": {
+			"subscription_id": "sub_123",
+			"seats": 5,
+			"ratio": 1.5
+		}
+	}`), charge)
+	r.NoError(t, e)
+
+	s, ok := charge.Metadata.GetString("subscription_id")
+	r.True(t, ok)
+	r.Equal(t, "sub_123", s)
+
+	_, ok = charge.Metadata.GetString("seats")
+	r.False(t, ok)
+	_, ok = charge.Metadata.GetString("missing")
+	r.False(t, ok)
+
+	n, ok := charge.Metadata.GetInt("seats")
+	r.True(t, ok)
+	r.Equal(t, int64(5), n)
+
+	_, ok = charge.Metadata.GetInt("ratio")
+	r.False(t, ok)
+	_, ok = charge.Metadata.GetInt("subscription_id")
+	r.False(t, ok)
+	_, ok = charge.Metadata.GetInt("missing")
+	r.False(t, ok)
+
+	n, ok = Metadata{"n": json.Number("42")}.GetInt("n")
+	r.True(t, ok)
+	r.Equal(t, int64(42), n)
+
+	var nilMetadata Metadata
+	_, ok = nilMetadata.GetInt("seats")
+	r.False(t, ok)
+}
+
+func TestMetadata_Nested(t *testing.T) {
+	input := `{
+		"plan": {
+			"features": ["api", "support"],
+			"limits": {"seats": 12345678901234567890, "ratio": 0.1}
+		},
+		"count": 3
+	}`
+
+	metadata := Metadata{}
+	r.NoError(t, json.Unmarshal([]byte(input), &metadata))
+
+	n, ok := metadata.GetInt("count")
+	r.True(t, ok)
+	r.Equal(t, int64(3), n)
+
+	plan, ok := metadata["plan"].(map[string]interface{})
+	r.True(t, ok)
+	r.Equal(t, []interface{}{"api", "support"}, plan["features"])
+
+	limits := plan["limits"].(map[string]interface{})
+	r.Equal(t, json.Number("12345678901234567890"), limits["seats"])
+	r.Equal(t, json.Number("0.1"), limits["ratio"])
+
+	output, e := json.Marshal(metadata)
+	r.NoError(t, e)
+	r.JSONEq(t, input, string(output))
+	r.Contains(t, string(output), "12345678901234567890")
+
+	metadata = Metadata{"stale": true}
+	r.NoError(t, json.Unmarshal([]byte(`null`), &metadata))
+	r.Nil(t, metadata)
+}
@@ -1,12 +1,26 @@
 package omise
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/omise/omise-go/schedule"
 )
 
 // Occurrence represents occurrence charge from Schedule
+//
+// Omise decides on its own whether and when a failed occurrence is retried; the number of
+// attempts and their spacing cannot be configured and are not reported on the Schedule.
+// RetryDate holds the date of the next planned retry, if any. Dunning strategies that need
+// more control should watch for failed occurrences and charge the customer directly.
+//
+// Omise's REST API cannot skip or cancel a single occurrence. To skip one cycle, destroy
+// the schedule and create a new one starting after the skipped occurrence.
+//
+// Metadata is decoded if present, but Omise does not currently send metadata on occurrences
+// and offers no way to attach any, so it is usually nil. Keep notes about occurrences, such
+// as retry decisions, in your own storage keyed by the occurrence ID.
 type Occurrence struct {
 	Base
 	Schedule     string                    `json:"schedule"`
@@ -15,5 +29,125 @@ type Occurrence struct {
 	ProcessedAt  time.Time                 `json:"processed_at"`
 	Status       schedule.OccurrenceStatus `json:"status"`
 	Message      string                    `json:"message"`
-	Result       string                    `json:"result"`
+	Result       OccurrenceResult          `json:"result"`
+	Metadata     Metadata                  `json:"metadata"`
+}
+
+// ChargeID returns the ID of the charge created by this occurrence, regardless of whether
+// the result was expanded. Returns an empty string if the occurrence did not create a
+// charge.
+func (occ *Occurrence) ChargeID() string {
+	switch {
+	case occ.Result.Charge != nil:
+		return occ.Result.Charge.ID
+	case strings.HasPrefix(occ.Result.ID, "chrg_"):
+		return occ.Result.ID
+	}
+
+	return ""
+}
+
+// TransferID returns the ID of the transfer created by this occurrence, regardless of
+// whether the result was expanded. Returns an empty string if the occurrence did not create
+// a transfer.
+func (occ *Occurrence) TransferID() string {
+	switch {
+	case occ.Result.Transfer != nil:
+		return occ.Result.Transfer.ID
+	case strings.HasPrefix(occ.Result.ID, "trsf_"):
+		return occ.Result.ID
+	}
+
+	return ""
+}
+
+// FailureCode returns the failure code of the charge or transfer created by this
+// occurrence, such as "insufficient_fund". It is only available when the result was
+// expanded; otherwise, or if the result did not fail, an empty string is returned. Retrieve
+// the charge by ChargeID to read it from an unexpanded result.
+func (occ *Occurrence) FailureCode() string {
+	switch {
+	case occ.Result.Charge != nil && occ.Result.Charge.FailureCode != nil:
+		return *occ.Result.Charge.FailureCode
+	case occ.Result.Transfer != nil && occ.Result.Transfer.FailureCode != nil:
+		return *occ.Result.Transfer.FailureCode
+	}
+
+	return ""
+}
+
+// FailureMessage returns the human readable failure message of the charge or transfer
+// created by this occurrence. Like FailureCode, it is only available when the result was
+// expanded. Message, in contrast, holds the occurrence's own message.
+func (occ *Occurrence) FailureMessage() string {
+	switch {
+	case occ.Result.Charge != nil && occ.Result.Charge.FailureMessage != nil:
+		return *occ.Result.Charge.FailureMessage
+	case occ.Result.Transfer != nil && occ.Result.Transfer.FailureMessage != nil:
+		return *occ.Result.Transfer.FailureMessage
+	}
+
+	return ""
+}
+
+// OccurrenceResult represents the result field of an Occurrence object. It always holds
+// the ID of the resulting object. If the result was expanded, the Charge or Transfer
+// field is also set depending on the kind of schedule.
+type OccurrenceResult struct {
+	ID       string
+	Charge   *Charge
+	Transfer *Transfer
+}
+
+// UnmarshalJSON decodes either a plain ID string or an expanded object.
+func (res *OccurrenceResult) UnmarshalJSON(buffer []byte) error {
+	*res = OccurrenceResult{}
+
+	if len(buffer) > 0 && buffer[0] == '"' {
+		return json.Unmarshal(buffer, &res.ID)
+	}
+
+	base := &Base{}
+	if e := json.Unmarshal(buffer, base); e != nil {
+		return e
+	}
+
+	res.ID = base.ID
+	switch base.Object {
+	case "charge":
+		res.Charge = &Charge{}
+		return json.Unmarshal(buffer, res.Charge)
+	case "transfer":
+		res.Transfer = &Transfer{}
+		return json.Unmarshal(buffer, res.Transfer)
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the expanded object if available, otherwise the ID string.
+func (res OccurrenceResult) MarshalJSON() ([]byte, error) {
+	switch {
+	case res.Charge != nil:
+		return json.Marshal(res.Charge)
+	case res.Transfer != nil:
+		return json.Marshal(res.Transfer)
+	case res.ID == "":
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(res.ID)
+}
+
+// WithStatus returns the occurrences in the list that have the given status. Omise's REST
+// API cannot filter occurrences by status so this is done on the retrieved page.
+func (list *OccurrenceList) WithStatus(status schedule.OccurrenceStatus) []*Occurrence {
+	var result []*Occurrence
+	for _, occ := range list.Data {
+		if occ.Status == status {
+			result = append(result, occ)
+		}
+	}
+
+	return result
 }
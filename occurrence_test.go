@@ -0,0 +1,96 @@
+package omise_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/omise/omise-go"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestOccurrence_Result(t *testing.T) {
+	occ := &Occurrence{}
+	e := json.Unmarshal([]byte(`{"object":"occurrence","result":"chrg_test_4yq7duw15p9hdrjp8oq"}`), occ)
+	r.NoError(t, e)
+	r.Equal(t, "chrg_test_4yq7duw15p9hdrjp8oq", occ.Result.ID)
+	r.Nil(t, occ.Result.Charge)
+	r.Equal(t, "chrg_test_4yq7duw15p9hdrjp8oq", occ.ChargeID())
+
+	occ = &Occurrence{}
+	e = json.Unmarshal([]byte(`{"object":"occurrence","result":{"object":"charge","id":"chrg_test_4yq7duw15p9hdrjp8oq","amount":100000}}`), occ)
+	r.NoError(t, e)
+	r.Equal(t, "chrg_test_4yq7duw15p9hdrjp8oq", occ.Result.ID)
+	r.NotNil(t, occ.Result.Charge)
+	r.Equal(t, Amount(100000), occ.Result.Charge.Amount)
+	r.Equal(t, "chrg_test_4yq7duw15p9hdrjp8oq", occ.ChargeID())
+
+	b, e := json.Marshal(occ.Result)
+	r.NoError(t, e)
+	r.Contains(t, string(b), `"amount":100000`)
+
+	occ = &Occurrence{}
+	e = json.Unmarshal([]byte(`{"object":"occurrence","result":"trsf_test_4yqacz8t3cbipcj766u"}`), occ)
+	r.NoError(t, e)
+	r.Equal(t, "trsf_test_4yqacz8t3cbipcj766u", occ.Result.ID)
+	r.Empty(t, occ.ChargeID())
+	r.Equal(t, "trsf_test_4yqacz8t3cbipcj766u", occ.TransferID())
+
+	occ = &Occurrence{}
+	e = json.Unmarshal([]byte(`{"object":"occurrence","result":{"object":"transfer","id":"trsf_test_4yqacz8t3cbipcj766u"}}`), occ)
+	r.NoError(t, e)
+	r.Equal(t, "trsf_test_4yqacz8t3cbipcj766u", occ.TransferID())
+	r.Empty(t, occ.ChargeID())
+
+	occ = &Occurrence{}
+	e = json.Unmarshal([]byte(`{"object":"occurrence","result":null}`), occ)
+	r.NoError(t, e)
+	r.Empty(t, occ.Result.ID)
+	r.Empty(t, occ.ChargeID())
+
+	b, e = json.Marshal(occ.Result)
+	r.NoError(t, e)
+	r.Equal(t, "null", string(b))
+}
+
+func TestOccurrence_Failure(t *testing.T) {
+	occ := &Occurrence{}
+	e := json.Unmarshal([]byte(`{"object":"occurrence","status":"failed","result":{
+		"object":"charge",
+		"id":"chrg_test_4yq7duw15p9hdrjp8oq",
+		"failure_code":"insufficient_fund",
+		"failure_message":"insufficient funds in the account or the card has reached the credit limit"
+	}}`), occ)
+	r.NoError(t, e)
+	r.Equal(t, "insufficient_fund", occ.FailureCode())
+	r.Equal(t, "insufficient funds in the account or the card has reached the credit limit", occ.FailureMessage())
+
+	occ = &Occurrence{}
+	e = json.Unmarshal([]byte(`{"object":"occurrence","result":{"object":"transfer","id":"trsf_test_4yqacz8t3cbipcj766u","failure_code":"insufficient_balance"}}`), occ)
+	r.NoError(t, e)
+	r.Equal(t, "insufficient_balance", occ.FailureCode())
+	r.Empty(t, occ.FailureMessage())
+
+	occ = &Occurrence{}
+	e = json.Unmarshal([]byte(`{"object":"occurrence","status":"failed","result":"chrg_test_4yq7duw15p9hdrjp8oq"}`), occ)
+	r.NoError(t, e)
+	r.Empty(t, occ.FailureCode())
+	r.Empty(t, occ.FailureMessage())
+}
+
+func TestOccurrence_Metadata(t *testing.T) {
+	occ := &Occurrence{}
+	e := json.Unmarshal([]byte(`{"object":"occurrence","metadata":{"note":"retry on payday","attempt":2}}`), occ)
+	r.NoError(t, e)
+
+	note, ok := occ.Metadata.GetString("note")
+	r.True(t, ok)
+	r.Equal(t, "retry on payday", note)
+
+	attempt, ok := occ.Metadata.GetInt("attempt")
+	r.True(t, ok)
+	r.Equal(t, int64(2), attempt)
+
+	occ = &Occurrence{}
+	r.NoError(t, json.Unmarshal([]byte(`{"object":"occurrence"}`), occ))
+	r.Nil(t, occ.Metadata)
+}
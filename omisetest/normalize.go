@@ -0,0 +1,58 @@
+// Package omisetest provides helpers for testing code that uses omise-go.
+package omisetest
+
+import (
+	"reflect"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Normalize zeroes every time.Time field reachable from obj, which must be a pointer, so
+// that resources decoded from different responses can be compared with reflect.DeepEqual
+// without tripping on server-assigned timestamps such as Created or ProcessedAt. Nested
+// structs, pointers and slices are walked; map values are left untouched.
+//
+// Fields of type omise.Date, such as a schedule's StartDate or NextOccurrences, are not
+// zeroed. They hold dates chosen when the resource was created rather than volatile
+// timestamps.
+//
+// Example:
+//
+//	omisetest.Normalize(got)
+//	omisetest.Normalize(want)
+//	if !reflect.DeepEqual(got, want) {
+//		t.Errorf("got %#v, want %#v", got, want)
+//	}
+//
+func Normalize(obj interface{}) {
+	normalize(reflect.ValueOf(obj))
+}
+
+func normalize(val reflect.Value) {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !val.IsNil() {
+			normalize(val.Elem())
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			normalize(val.Index(i))
+		}
+
+	case reflect.Struct:
+		if val.Type() == timeType {
+			if val.CanSet() {
+				val.Set(reflect.Zero(timeType))
+			}
+			return
+		}
+
+		for i := 0; i < val.NumField(); i++ {
+			if field := val.Field(i); field.CanSet() {
+				normalize(field)
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package omisetest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/omise/omise-go"
+	. "github.com/omise/omise-go/omisetest"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	created := time.Date(2017, 5, 15, 17, 35, 1, 0, time.UTC)
+	start := omise.Date(time.Date(2017, 5, 16, 0, 0, 0, 0, time.UTC))
+	newSchedule := func(created time.Time) *omise.Schedule {
+		return &omise.Schedule{
+			Base:      omise.Base{ID: "schd_57z9hj228pusa652nk1", Created: created},
+			Every:     3,
+			StartDate: start,
+			Occurrences: omise.OccurrenceList{
+				Data: []*omise.Occurrence{
+					{Base: omise.Base{Created: created}, ProcessedAt: created},
+				},
+			},
+		}
+	}
+
+	a, b := newSchedule(created), newSchedule(created.Add(time.Hour))
+	r.NotEqual(t, a, b)
+
+	Normalize(a)
+	Normalize(b)
+	r.Equal(t, a, b)
+	r.True(t, a.Created.IsZero())
+	r.True(t, a.Occurrences.Data[0].ProcessedAt.IsZero())
+	r.Equal(t, "schd_57z9hj228pusa652nk1", a.ID)
+	r.Equal(t, 3, a.Every)
+	r.Equal(t, start, a.StartDate)
+}
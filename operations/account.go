@@ -1,6 +1,7 @@
 package operations
 
 import (
+	omise "github.com/omise/omise-go"
 	"github.com/omise/omise-go/internal"
 )
 
@@ -17,8 +18,23 @@ type RetrieveAccount struct{}
 
 func (req *RetrieveAccount) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/account",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/account",
+		Retryable: true,
 	}
 }
+
+// Ping performs a cheap authenticated request to verify connectivity to Omise's REST API
+// and the validity of the client's secret key. Returns nil on success. Invalid keys result
+// in an *omise.Error with a StatusCode of 401.
+//
+// Example:
+//
+//	if e := Ping(client); e != nil {
+//		log.Fatalln("omise unavailable:", e)
+//	}
+//
+func Ping(client *omise.Client) error {
+	return client.Do(nil, &RetrieveAccount{})
+}
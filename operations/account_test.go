@@ -1,7 +1,12 @@
 package operations_test
 
 import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/omise/omise-go"
 	"github.com/omise/omise-go/internal/testutil"
@@ -14,6 +19,39 @@ func TestAccount(t *testing.T) {
 	account := &omise.Account{}
 	client.MustDo(account, &RetrieveAccount{})
 	r.Equal(t, account.ID, "acct_4yq6tcsyoged5c0ocxd")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	account = &omise.Account{}
+	client.MustDoWithContext(ctx, account, &RetrieveAccount{})
+	r.Equal(t, account.ID, "acct_4yq6tcsyoged5c0ocxd")
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestPing(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+	r.NoError(t, Ping(client.Client))
+
+	client.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"object":"error","code":"authentication_failure","message":"authentication failed"}`
+		return &http.Response{
+			StatusCode: 401,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	})
+
+	err, ok := Ping(client.Client).(*omise.Error)
+	if r.True(t, ok) {
+		r.Equal(t, 401, err.StatusCode)
+		r.Equal(t, "authentication_failure", err.Code)
+	}
 }
 
 func TestAccount_Network(t *testing.T) {
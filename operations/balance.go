@@ -17,8 +17,9 @@ type RetrieveBalance struct{}
 
 func (req *RetrieveBalance) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/balance",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/balance",
+		Retryable: true,
 	}
 }
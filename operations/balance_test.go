@@ -13,7 +13,7 @@ func TestBalance(t *testing.T) {
 	client := testutil.NewFixedClient(t)
 	balance := &omise.Balance{}
 	client.MustDo(balance, &RetrieveBalance{})
-	r.Equal(t, int64(96094), balance.Total)
+	r.Equal(t, omise.Amount(96094), balance.Total)
 	r.Equal(t, "thb", balance.Currency)
 }
 
@@ -0,0 +1,27 @@
+package operations
+
+import (
+	"github.com/omise/omise-go/internal"
+)
+
+// Example:
+//
+//	capability := &omise.Capability{}
+//	if e := client.Do(capability, &RetrieveCapability{}); e != nil {
+//		panic(e)
+//	}
+//
+//	for _, method := range capability.MethodsForCurrency("thb") {
+//		fmt.Println("supported:", method.Name)
+//	}
+//
+type RetrieveCapability struct{}
+
+func (req *RetrieveCapability) Op() *internal.Op {
+	return &internal.Op{
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/capability",
+		Retryable: true,
+	}
+}
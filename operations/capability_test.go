@@ -0,0 +1,40 @@
+package operations_test
+
+import (
+	"testing"
+
+	"github.com/omise/omise-go"
+	"github.com/omise/omise-go/internal/testutil"
+	. "github.com/omise/omise-go/operations"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestCapability(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+	capability := &omise.Capability{}
+	client.MustDo(capability, &RetrieveCapability{})
+	r.Equal(t, "capability", capability.Object)
+	r.Len(t, capability.PaymentMethods, 3)
+
+	methods := capability.MethodsForCurrency("thb")
+	r.Len(t, methods, 2)
+	r.Equal(t, "card", methods[0].Name)
+	r.Equal(t, "internet_banking_scb", methods[1].Name)
+
+	methods = capability.MethodsForCurrency("USD")
+	r.Len(t, methods, 1)
+	r.Equal(t, "card", methods[0].Name)
+
+	r.Empty(t, capability.MethodsForCurrency("jpy"))
+}
+
+func TestCapability_Network(t *testing.T) {
+	testutil.Require(t, "network")
+	client := testutil.NewTestClient(t)
+
+	capability := &omise.Capability{}
+	client.MustDo(capability, &RetrieveCapability{})
+	r.Equal(t, "capability", capability.Object)
+
+	testutil.LogObj(t, capability)
+}
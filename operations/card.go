@@ -1,6 +1,7 @@
 package operations
 
 import (
+	"net/url"
 	"time"
 
 	"github.com/omise/omise-go/internal"
@@ -24,9 +25,11 @@ type ListCards struct {
 
 func (req *ListCards) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/customers/" + req.CustomerID + "/cards",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/customers/" + url.PathEscape(req.CustomerID) + "/cards",
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
 
@@ -60,9 +63,10 @@ type RetrieveCard struct {
 
 func (req *RetrieveCard) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/customers/" + req.CustomerID + "/cards/" + req.CardID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/customers/" + url.PathEscape(req.CustomerID) + "/cards/" + url.PathEscape(req.CardID),
+		Retryable: true,
 	}
 }
 
@@ -95,7 +99,7 @@ func (req *UpdateCard) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "PATCH",
-		Path:     "/customers/" + req.CustomerID + "/cards/" + req.CardID,
+		Path:     "/customers/" + url.PathEscape(req.CustomerID) + "/cards/" + url.PathEscape(req.CardID),
 	}
 }
 
@@ -120,6 +124,6 @@ func (req *DestroyCard) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "DELETE",
-		Path:     "/customers/" + req.CustomerID + "/cards/" + req.CardID,
+		Path:     "/customers/" + url.PathEscape(req.CustomerID) + "/cards/" + url.PathEscape(req.CardID),
 	}
 }
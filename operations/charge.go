@@ -1,6 +1,7 @@
 package operations
 
 import (
+	"errors"
 	"net/url"
 
 	"github.com/omise/omise-go"
@@ -27,9 +28,11 @@ type ListCharges struct {
 
 func (req *ListCharges) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/charges",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/charges",
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
 
@@ -61,6 +64,16 @@ type CreateCharge struct {
 	ReturnURI   string `query:"return_uri"`
 }
 
+// Validate checks that Currency, if given, is a valid ISO 4217 currency code.
+func (req *CreateCharge) Validate() error {
+	if req.Currency == "" {
+		return nil
+	}
+
+	_, e := omise.NormalizeCurrency(req.Currency)
+	return e
+}
+
 func (req *CreateCharge) Op() *internal.Op {
 	op := &internal.Op{
 		Endpoint: internal.API,
@@ -72,6 +85,9 @@ func (req *CreateCharge) Op() *internal.Op {
 	if req.DontCapture {
 		op.Values.Set("capture", "false")
 	}
+	if currency, e := omise.NormalizeCurrency(req.Currency); e == nil {
+		op.Values.Set("currency", currency)
+	}
 	return op
 }
 
@@ -96,7 +112,7 @@ func (req *UpdateCharge) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "PATCH",
-		Path:     "/charges/" + req.ChargeID,
+		Path:     "/charges/" + url.PathEscape(req.ChargeID),
 	}
 }
 
@@ -115,34 +131,57 @@ type RetrieveCharge struct {
 
 func (req *RetrieveCharge) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/charges/" + req.ChargeID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/charges/" + url.PathEscape(req.ChargeID),
+		Retryable: true,
 	}
 }
 
+// ErrCaptureAmountExceeded is returned when capturing more than the authorized amount of
+// the charge given to CaptureCharge.
+var ErrCaptureAmountExceeded = errors.New("capture amount exceeds the authorized amount")
+
 // If you have created a charge and passed capture=false you'll have an authorized only
 // charge that you can capture at a later time. You can hold it for as long as permitted
 // by the issuing bank. This delay may vary between cards from 1 to 30 days.
 //
+// Set Amount to capture only part of the authorized amount. If the authorized Charge is
+// also given, Amount is checked against it before the request is sent.
+//
 // Example:
 //
 //	charge := &omise.Charge{ID: "chrg_1234"}
-//	if e := client.Do(charge, &CaptureCharge{charge.ID}); e != nil {
+//	if e := client.Do(charge, &CaptureCharge{ChargeID: charge.ID}); e != nil {
 //		panic(e)
 //	}
 //
 //	fmt.Println("captured:", charge.Captured)
 //
 type CaptureCharge struct {
-	ChargeID string
+	ChargeID string        `query:"-"`
+	Amount   int64         `query:"capture_amount"`
+	Charge   *omise.Charge `query:"-"`
+}
+
+// Validate checks that Amount is not negative and, if Charge is given, that it does not
+// exceed the authorized amount.
+func (req *CaptureCharge) Validate() error {
+	switch {
+	case req.Amount < 0:
+		return ErrNegativeChargeAmount
+	case req.Charge != nil && req.Amount > int64(req.Charge.Amount):
+		return ErrCaptureAmountExceeded
+	}
+
+	return nil
 }
 
 func (req *CaptureCharge) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "POST",
-		Path:     "/charges/" + req.ChargeID + "/capture",
+		Path:     "/charges/" + url.PathEscape(req.ChargeID) + "/capture",
 	}
 }
 
@@ -157,6 +196,6 @@ func (req *ReverseCharge) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "POST",
-		Path:     "/charges/" + req.ChargeID + "/reverse",
+		Path:     "/charges/" + url.PathEscape(req.ChargeID) + "/reverse",
 	}
 }
@@ -1,6 +1,7 @@
 package operations_test
 
 import (
+	"io/ioutil"
 	"testing"
 	"time"
 
@@ -49,6 +50,44 @@ func TestCharge(t *testing.T) {
 	r.EqualError(t, e, "(404/not_found) customer missing was not found")
 }
 
+func TestCreateCharge_Currency(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+
+	req, e := client.Request(&CreateCharge{Amount: 100000, Currency: "THB"})
+	r.NoError(t, e)
+	body, e := ioutil.ReadAll(req.Body)
+	r.NoError(t, e)
+	r.Contains(t, string(body), "currency=thb")
+
+	_, e = client.Request(&CreateCharge{Amount: 100000, Currency: "thx"})
+	r.Error(t, e)
+	r.Equal(t, omise.ErrInvalidCurrency("thx"), e)
+}
+
+func TestCaptureCharge(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+	charge := &omise.Charge{Base: omise.Base{ID: "chrg_test_4yq7duw15p9hdrjp8oq"}, Amount: 100000}
+
+	req, e := client.Request(&CaptureCharge{ChargeID: charge.ID, Amount: 40000, Charge: charge})
+	r.NoError(t, e)
+	r.Equal(t, "/charges/chrg_test_4yq7duw15p9hdrjp8oq/capture", req.URL.Path)
+	body, e := ioutil.ReadAll(req.Body)
+	r.NoError(t, e)
+	r.Equal(t, "capture_amount=40000", string(body))
+
+	_, e = client.Request(&CaptureCharge{ChargeID: charge.ID, Amount: 100001, Charge: charge})
+	r.Equal(t, ErrCaptureAmountExceeded, e)
+
+	_, e = client.Request(&CaptureCharge{ChargeID: charge.ID, Amount: -1})
+	r.Equal(t, ErrNegativeChargeAmount, e)
+
+	req, e = client.Request(&CaptureCharge{ChargeID: charge.ID})
+	r.NoError(t, e)
+	body, e = ioutil.ReadAll(req.Body)
+	r.NoError(t, e)
+	r.Empty(t, body)
+}
+
 func TestCharge_Network(t *testing.T) {
 	testutil.Require(t, "network")
 	client := testutil.NewTestClient(t)
@@ -1,6 +1,9 @@
 package operations
 
 import (
+	"net/url"
+
+	"github.com/omise/omise-go"
 	"github.com/omise/omise-go/internal"
 )
 
@@ -24,9 +27,11 @@ type ListCustomers struct {
 
 func (req *ListCustomers) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/customers",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/customers",
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
 
@@ -72,9 +77,10 @@ type RetrieveCustomer struct {
 
 func (req *RetrieveCustomer) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/customers/" + req.CustomerID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/customers/" + url.PathEscape(req.CustomerID),
+		Retryable: true,
 	}
 }
 
@@ -95,14 +101,68 @@ type UpdateCustomer struct {
 	Email       string
 	Description string
 	Card        string
+	DefaultCard string `query:"default_card"`
 }
 
 func (req *UpdateCustomer) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "PATCH",
-		Path:     "/customers/" + req.CustomerID,
+		Path:     "/customers/" + url.PathEscape(req.CustomerID),
+	}
+}
+
+// AttachCardToCustomer attaches the card represented by the given token to an existing
+// customer by issuing an UpdateCustomer operation with the token as Card.
+//
+// Example:
+//
+//	customer, e := AttachCardToCustomer(client, "cust_987", token.ID)
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println("attached card:", customer.Cards.Data[len(customer.Cards.Data)-1].ID)
+//
+func AttachCardToCustomer(client *omise.Client, customerID, token string) (*omise.Customer, error) {
+	customer := &omise.Customer{}
+	if e := client.Do(customer, &UpdateCustomer{
+		CustomerID: customerID,
+		Card:       token,
+	}); e != nil {
+		return nil, e
 	}
+
+	return customer, nil
+}
+
+// SetCustomerDefaultCard makes the given card, which must already be attached to the
+// customer, the customer's default card by issuing an UpdateCustomer operation.
+//
+// Occurrences of charge schedules created without a Card charge the customer's default
+// card at the time they are processed, so such schedules pick up the new card from their
+// next occurrence. Schedules created with an explicit Card keep charging that card and
+// must be destroyed and created again to use another one.
+//
+// Example:
+//
+//	customer, e := SetCustomerDefaultCard(client, "cust_987", "card_456")
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println("default card:", customer.DefaultCard)
+//
+func SetCustomerDefaultCard(client *omise.Client, customerID, cardID string) (*omise.Customer, error) {
+	customer := &omise.Customer{}
+	if e := client.Do(customer, &UpdateCustomer{
+		CustomerID:  customerID,
+		DefaultCard: cardID,
+	}); e != nil {
+		return nil, e
+	}
+
+	return customer, nil
 }
 
 // Example:
@@ -124,6 +184,6 @@ func (req *DestroyCustomer) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "DELETE",
-		Path:     "/customers/" + req.CustomerID,
+		Path:     "/customers/" + url.PathEscape(req.CustomerID),
 	}
 }
@@ -1,6 +1,7 @@
 package operations_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -52,6 +53,44 @@ func TestCustomer(t *testing.T) {
 	r.EqualError(t, e, "(404/not_found) customer missing was not found")
 }
 
+func TestAttachCardToCustomer(t *testing.T) {
+	const (
+		CustomerID = "cust_test_4yq6txdpfadhbaqnwp3"
+		TokenID    = "tokn_test_4yq8lbecl0q6dsjzxr5"
+	)
+
+	client := testutil.NewFixedClient(t)
+
+	customer, e := AttachCardToCustomer(client.Client, CustomerID, TokenID)
+	r.NoError(t, e)
+	r.Equal(t, CustomerID, customer.ID)
+
+	_, e = AttachCardToCustomer(client.Client, "not_exist", TokenID)
+	r.Error(t, e)
+}
+
+func TestSetCustomerDefaultCard(t *testing.T) {
+	const (
+		CustomerID = "cust_test_4yq6txdpfadhbaqnwp3"
+		CardID     = "card_test_4yq6tuucl9h4erukfl0"
+	)
+
+	client := testutil.NewFixedClient(t)
+
+	var body []byte
+	client.BeforeSend = func(ctx context.Context, operation Operation, b []byte) {
+		body = b
+	}
+
+	customer, e := SetCustomerDefaultCard(client.Client, CustomerID, CardID)
+	r.NoError(t, e)
+	r.Equal(t, CustomerID, customer.ID)
+	r.Equal(t, "default_card="+CardID, string(body))
+
+	_, e = SetCustomerDefaultCard(client.Client, "not_exist", CardID)
+	r.Error(t, e)
+}
+
 func TestCustomer_Network(t *testing.T) {
 	testutil.Require(t, "network")
 	client := testutil.NewTestClient(t)
@@ -1,6 +1,8 @@
 package operations
 
 import (
+	"net/url"
+
 	"github.com/omise/omise-go"
 	"github.com/omise/omise-go/internal"
 )
@@ -33,9 +35,11 @@ func (req *ListDisputes) Op() *internal.Op {
 	}
 
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     path,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      path,
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
 
@@ -54,9 +58,10 @@ type RetrieveDispute struct {
 
 func (req *RetrieveDispute) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/disputes/" + req.DisputeID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/disputes/" + url.PathEscape(req.DisputeID),
+		Retryable: true,
 	}
 }
 
@@ -81,6 +86,6 @@ func (req *UpdateDispute) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "PATCH",
-		Path:     "/disputes/" + req.DisputeID,
+		Path:     "/disputes/" + url.PathEscape(req.DisputeID),
 	}
 }
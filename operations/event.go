@@ -1,6 +1,8 @@
 package operations
 
 import (
+	"net/url"
+
 	"github.com/omise/omise-go/internal"
 )
 
@@ -19,9 +21,11 @@ type ListEvents struct {
 
 func (req *ListEvents) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/events",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/events",
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
 
@@ -40,8 +44,9 @@ type RetrieveEvent struct {
 
 func (req *RetrieveEvent) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/events/" + req.EventID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/events/" + url.PathEscape(req.EventID),
+		Retryable: true,
 	}
 }
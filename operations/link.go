@@ -1,6 +1,8 @@
 package operations
 
 import (
+	"net/url"
+
 	"github.com/omise/omise-go/internal"
 )
 
@@ -10,9 +12,11 @@ type ListLinks struct {
 
 func (req *ListLinks) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/links",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/links",
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
 
@@ -38,8 +42,9 @@ type RetrieveLink struct {
 
 func (req *RetrieveLink) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/links/" + req.LinkID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/links/" + url.PathEscape(req.LinkID),
+		Retryable: true,
 	}
 }
@@ -26,9 +26,9 @@ func TestLink_Network(t *testing.T) {
 	r.NotNil(t, link)
 
 	t.Log("created link:", link.ID)
-	r.Equal(t, int64(99900), link.Amount)
+	r.Equal(t, omise.Amount(99900), link.Amount)
 	r.Equal(t, "Hot Latte", link.Title)
-	r.True(t, link.Multiple)
+	r.True(t, bool(link.Multiple))
 
 	// retrieve created link
 	link2 := &omise.Link{}
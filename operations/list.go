@@ -7,6 +7,9 @@ import (
 	"github.com/omise/omise-go"
 )
 
+//go:generate go run ../internal/generator/main.go list_operations
+//go:generate go fmt list_operations.go
+
 // List contains fields that represent parameters common to most list operations. List
 // struct is not an operation in and of itself and cannot be used with client.Do directly.
 // Use one of the predefined XXXList operations defined blow instead and supply List
@@ -14,12 +17,43 @@ import (
 //
 // See the Pagination and Lists documentation at https://www.omise.co/api-pagination for
 // more information.
+//
+// Every list operation also provides chainable WithOffset, WithLimit, WithFrom, WithTo,
+// WithOrder and WithTimeout helpers as an alternative to embedding the List struct literal:
+//
+//	schds, list := &omise.ScheduleList{}, ListSchedules{}.WithLimit(50).WithFrom(from)
+//	if e := client.Do(schds, list); e != nil {
+//		panic(e)
+//	}
+//
+// Setting IfModifiedSince sends the operation as a conditional request. If the server
+// responds with 304 Not Modified, client.Do returns omise.ErrNotModified and leaves the
+// result untouched. Omise does not document ETag support so only If-Modified-Since is
+// available.
+//
+// Omise's REST API only supports offset-based pagination; there are no cursor parameters.
+// Objects created while paging shift the offsets, which can skip or duplicate objects.
+// For stable exports, fix To to the time the export started and use Chronological order
+// so that objects created during the export fall outside the listed range. Objects
+// deleted during the export can still shift later pages.
+//
+// Timeout, if non-zero, overrides the client's Timeout for this operation, which is useful
+// for fetching large pages over slow links. Other operations can override it by sending
+// them with a context from omise.WithOperationTimeout.
 type List struct {
 	Offset int
 	Limit  int
 	From   time.Time
 	To     time.Time
 	Order  omise.Ordering
+
+	IfModifiedSince time.Time     `query:"-"`
+	Timeout         time.Duration `query:"-"`
+}
+
+// ModifiedSince implements internal.Conditional.
+func (l List) ModifiedSince() time.Time {
+	return l.IfModifiedSince
 }
 
 // MarshalJSON List type
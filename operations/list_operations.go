@@ -0,0 +1,480 @@
+/* !!! DO NOT MODIFY !!!
+ *
+ * This file is auto-generated by internal/generator package.
+ */
+
+package operations
+
+import (
+	"time"
+
+	"github.com/omise/omise-go"
+)
+
+// WithOffset returns a copy of the ListCards operation with the Offset parameter set.
+func (req ListCards) WithOffset(offset int) *ListCards {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListCards operation with the Limit parameter set.
+func (req ListCards) WithLimit(limit int) *ListCards {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListCards operation with the From parameter set.
+func (req ListCards) WithFrom(from time.Time) *ListCards {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListCards operation with the To parameter set.
+func (req ListCards) WithTo(to time.Time) *ListCards {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListCards operation with the Order parameter set.
+func (req ListCards) WithOrder(order omise.Ordering) *ListCards {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListCards operation with the Timeout parameter set.
+func (req ListCards) WithTimeout(timeout time.Duration) *ListCards {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListCharges operation with the Offset parameter set.
+func (req ListCharges) WithOffset(offset int) *ListCharges {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListCharges operation with the Limit parameter set.
+func (req ListCharges) WithLimit(limit int) *ListCharges {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListCharges operation with the From parameter set.
+func (req ListCharges) WithFrom(from time.Time) *ListCharges {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListCharges operation with the To parameter set.
+func (req ListCharges) WithTo(to time.Time) *ListCharges {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListCharges operation with the Order parameter set.
+func (req ListCharges) WithOrder(order omise.Ordering) *ListCharges {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListCharges operation with the Timeout parameter set.
+func (req ListCharges) WithTimeout(timeout time.Duration) *ListCharges {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListCustomerSchedules operation with the Offset parameter set.
+func (req ListCustomerSchedules) WithOffset(offset int) *ListCustomerSchedules {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListCustomerSchedules operation with the Limit parameter set.
+func (req ListCustomerSchedules) WithLimit(limit int) *ListCustomerSchedules {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListCustomerSchedules operation with the From parameter set.
+func (req ListCustomerSchedules) WithFrom(from time.Time) *ListCustomerSchedules {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListCustomerSchedules operation with the To parameter set.
+func (req ListCustomerSchedules) WithTo(to time.Time) *ListCustomerSchedules {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListCustomerSchedules operation with the Order parameter set.
+func (req ListCustomerSchedules) WithOrder(order omise.Ordering) *ListCustomerSchedules {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListCustomerSchedules operation with the Timeout parameter set.
+func (req ListCustomerSchedules) WithTimeout(timeout time.Duration) *ListCustomerSchedules {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListCustomers operation with the Offset parameter set.
+func (req ListCustomers) WithOffset(offset int) *ListCustomers {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListCustomers operation with the Limit parameter set.
+func (req ListCustomers) WithLimit(limit int) *ListCustomers {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListCustomers operation with the From parameter set.
+func (req ListCustomers) WithFrom(from time.Time) *ListCustomers {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListCustomers operation with the To parameter set.
+func (req ListCustomers) WithTo(to time.Time) *ListCustomers {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListCustomers operation with the Order parameter set.
+func (req ListCustomers) WithOrder(order omise.Ordering) *ListCustomers {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListCustomers operation with the Timeout parameter set.
+func (req ListCustomers) WithTimeout(timeout time.Duration) *ListCustomers {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListDisputes operation with the Offset parameter set.
+func (req ListDisputes) WithOffset(offset int) *ListDisputes {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListDisputes operation with the Limit parameter set.
+func (req ListDisputes) WithLimit(limit int) *ListDisputes {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListDisputes operation with the From parameter set.
+func (req ListDisputes) WithFrom(from time.Time) *ListDisputes {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListDisputes operation with the To parameter set.
+func (req ListDisputes) WithTo(to time.Time) *ListDisputes {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListDisputes operation with the Order parameter set.
+func (req ListDisputes) WithOrder(order omise.Ordering) *ListDisputes {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListDisputes operation with the Timeout parameter set.
+func (req ListDisputes) WithTimeout(timeout time.Duration) *ListDisputes {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListEvents operation with the Offset parameter set.
+func (req ListEvents) WithOffset(offset int) *ListEvents {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListEvents operation with the Limit parameter set.
+func (req ListEvents) WithLimit(limit int) *ListEvents {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListEvents operation with the From parameter set.
+func (req ListEvents) WithFrom(from time.Time) *ListEvents {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListEvents operation with the To parameter set.
+func (req ListEvents) WithTo(to time.Time) *ListEvents {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListEvents operation with the Order parameter set.
+func (req ListEvents) WithOrder(order omise.Ordering) *ListEvents {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListEvents operation with the Timeout parameter set.
+func (req ListEvents) WithTimeout(timeout time.Duration) *ListEvents {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListLinks operation with the Offset parameter set.
+func (req ListLinks) WithOffset(offset int) *ListLinks {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListLinks operation with the Limit parameter set.
+func (req ListLinks) WithLimit(limit int) *ListLinks {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListLinks operation with the From parameter set.
+func (req ListLinks) WithFrom(from time.Time) *ListLinks {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListLinks operation with the To parameter set.
+func (req ListLinks) WithTo(to time.Time) *ListLinks {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListLinks operation with the Order parameter set.
+func (req ListLinks) WithOrder(order omise.Ordering) *ListLinks {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListLinks operation with the Timeout parameter set.
+func (req ListLinks) WithTimeout(timeout time.Duration) *ListLinks {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListRecipients operation with the Offset parameter set.
+func (req ListRecipients) WithOffset(offset int) *ListRecipients {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListRecipients operation with the Limit parameter set.
+func (req ListRecipients) WithLimit(limit int) *ListRecipients {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListRecipients operation with the From parameter set.
+func (req ListRecipients) WithFrom(from time.Time) *ListRecipients {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListRecipients operation with the To parameter set.
+func (req ListRecipients) WithTo(to time.Time) *ListRecipients {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListRecipients operation with the Order parameter set.
+func (req ListRecipients) WithOrder(order omise.Ordering) *ListRecipients {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListRecipients operation with the Timeout parameter set.
+func (req ListRecipients) WithTimeout(timeout time.Duration) *ListRecipients {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListRefunds operation with the Offset parameter set.
+func (req ListRefunds) WithOffset(offset int) *ListRefunds {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListRefunds operation with the Limit parameter set.
+func (req ListRefunds) WithLimit(limit int) *ListRefunds {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListRefunds operation with the From parameter set.
+func (req ListRefunds) WithFrom(from time.Time) *ListRefunds {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListRefunds operation with the To parameter set.
+func (req ListRefunds) WithTo(to time.Time) *ListRefunds {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListRefunds operation with the Order parameter set.
+func (req ListRefunds) WithOrder(order omise.Ordering) *ListRefunds {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListRefunds operation with the Timeout parameter set.
+func (req ListRefunds) WithTimeout(timeout time.Duration) *ListRefunds {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListScheduleOccurrences operation with the Offset parameter set.
+func (req ListScheduleOccurrences) WithOffset(offset int) *ListScheduleOccurrences {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListScheduleOccurrences operation with the Limit parameter set.
+func (req ListScheduleOccurrences) WithLimit(limit int) *ListScheduleOccurrences {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListScheduleOccurrences operation with the From parameter set.
+func (req ListScheduleOccurrences) WithFrom(from time.Time) *ListScheduleOccurrences {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListScheduleOccurrences operation with the To parameter set.
+func (req ListScheduleOccurrences) WithTo(to time.Time) *ListScheduleOccurrences {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListScheduleOccurrences operation with the Order parameter set.
+func (req ListScheduleOccurrences) WithOrder(order omise.Ordering) *ListScheduleOccurrences {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListScheduleOccurrences operation with the Timeout parameter set.
+func (req ListScheduleOccurrences) WithTimeout(timeout time.Duration) *ListScheduleOccurrences {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListSchedules operation with the Offset parameter set.
+func (req ListSchedules) WithOffset(offset int) *ListSchedules {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListSchedules operation with the Limit parameter set.
+func (req ListSchedules) WithLimit(limit int) *ListSchedules {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListSchedules operation with the From parameter set.
+func (req ListSchedules) WithFrom(from time.Time) *ListSchedules {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListSchedules operation with the To parameter set.
+func (req ListSchedules) WithTo(to time.Time) *ListSchedules {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListSchedules operation with the Order parameter set.
+func (req ListSchedules) WithOrder(order omise.Ordering) *ListSchedules {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListSchedules operation with the Timeout parameter set.
+func (req ListSchedules) WithTimeout(timeout time.Duration) *ListSchedules {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListTransactions operation with the Offset parameter set.
+func (req ListTransactions) WithOffset(offset int) *ListTransactions {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListTransactions operation with the Limit parameter set.
+func (req ListTransactions) WithLimit(limit int) *ListTransactions {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListTransactions operation with the From parameter set.
+func (req ListTransactions) WithFrom(from time.Time) *ListTransactions {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListTransactions operation with the To parameter set.
+func (req ListTransactions) WithTo(to time.Time) *ListTransactions {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListTransactions operation with the Order parameter set.
+func (req ListTransactions) WithOrder(order omise.Ordering) *ListTransactions {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListTransactions operation with the Timeout parameter set.
+func (req ListTransactions) WithTimeout(timeout time.Duration) *ListTransactions {
+	req.Timeout = timeout
+	return &req
+}
+
+// WithOffset returns a copy of the ListTransfers operation with the Offset parameter set.
+func (req ListTransfers) WithOffset(offset int) *ListTransfers {
+	req.Offset = offset
+	return &req
+}
+
+// WithLimit returns a copy of the ListTransfers operation with the Limit parameter set.
+func (req ListTransfers) WithLimit(limit int) *ListTransfers {
+	req.Limit = limit
+	return &req
+}
+
+// WithFrom returns a copy of the ListTransfers operation with the From parameter set.
+func (req ListTransfers) WithFrom(from time.Time) *ListTransfers {
+	req.From = from
+	return &req
+}
+
+// WithTo returns a copy of the ListTransfers operation with the To parameter set.
+func (req ListTransfers) WithTo(to time.Time) *ListTransfers {
+	req.To = to
+	return &req
+}
+
+// WithOrder returns a copy of the ListTransfers operation with the Order parameter set.
+func (req ListTransfers) WithOrder(order omise.Ordering) *ListTransfers {
+	req.Order = order
+	return &req
+}
+
+// WithTimeout returns a copy of the ListTransfers operation with the Timeout parameter set.
+func (req ListTransfers) WithTimeout(timeout time.Duration) *ListTransfers {
+	req.Timeout = timeout
+	return &req
+}
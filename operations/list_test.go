@@ -67,3 +67,34 @@ func TestListMarshal(t *testing.T) {
 		r.Equal(t, td.expected, string(b))
 	}
 }
+
+func TestListOperationsWith(t *testing.T) {
+	from := time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2017, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	list := ListSchedules{}.
+		WithOffset(1).
+		WithLimit(50).
+		WithFrom(from).
+		WithTo(to).
+		WithOrder(omise.ReverseChronological).
+		WithTimeout(time.Minute)
+
+	r.Equal(t, &ListSchedules{
+		List{
+			Offset:  1,
+			Limit:   50,
+			From:    from,
+			To:      to,
+			Order:   omise.ReverseChronological,
+			Timeout: time.Minute,
+		},
+	}, list)
+	r.Equal(t, time.Minute, list.Op().Timeout)
+	r.Equal(t, time.Minute, ListCharges{}.WithTimeout(time.Minute).Op().Timeout)
+
+	// must not modify the original operation
+	base := &ListCharges{List{Limit: 10}}
+	r.Equal(t, 20, base.WithLimit(20).Limit)
+	r.Equal(t, 10, base.Limit)
+}
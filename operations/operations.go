@@ -6,3 +6,76 @@
 // https://www.omise.co/charges-api for the most up-to-date version of what each of these
 // operations does.
 package operations
+
+import (
+	omise "github.com/omise/omise-go"
+	"github.com/omise/omise-go/internal"
+)
+
+// Operation is implemented by every operation in this package. It is the interface
+// accepted by Client.Do so that operations can be collected in a slice, e.g. for DoBatch,
+// or named in a Client.BeforeSend hook.
+type Operation = omise.Operation
+
+// NoOp is a trivial operation with no parameters. It is mainly useful for testing code
+// that wraps the client, such as retry or logging middlewares, without depending on a
+// real operation. Method defaults to GET when empty.
+//
+// Example:
+//
+//	if e := client.Do(nil, &NoOp{Method: "GET", Path: "/account"}); e != nil {
+//		panic(e)
+//	}
+//
+type NoOp struct {
+	Method string `query:"-"`
+	Path   string `query:"-"`
+}
+
+func (req *NoOp) Op() *internal.Op {
+	method := req.Method
+	if method == "" {
+		method = "GET"
+	}
+
+	return &internal.Op{
+		Endpoint: internal.API,
+		Method:   method,
+		Path:     req.Path,
+	}
+}
+
+// DoBatch performs each operation in turn, decoding the response of ops[i] into
+// results[i]. Omise's REST API does not offer a batch endpoint, so operations are sent
+// sequentially and a failing operation does not stop the rest from being performed. The
+// returned slice has the same length as ops and holds the error, if any, of each
+// operation. Operations without a matching entry in results have their response
+// discarded.
+//
+// Example:
+//
+//	charge, customer := &omise.Charge{}, &omise.Customer{}
+//	errs := DoBatch(client, []Operation{
+//		&RetrieveCharge{"chrg_test_4yq7duw15p9hdrjp8oq"},
+//		&RetrieveCustomer{"cust_test_4yq6txdpfadhbaqnwp3"},
+//	}, []interface{}{charge, customer})
+//
+//	for i, e := range errs {
+//		if e != nil {
+//			fmt.Println("operation", i, "failed:", e)
+//		}
+//	}
+//
+func DoBatch(client *omise.Client, ops []Operation, results []interface{}) []error {
+	errs := make([]error, len(ops))
+	for i, op := range ops {
+		var result interface{}
+		if i < len(results) {
+			result = results[i]
+		}
+
+		errs[i] = client.Do(result, op)
+	}
+
+	return errs
+}
@@ -1,12 +1,14 @@
 package operations_test
 
 import (
+	"io/ioutil"
 	"testing"
 	"time"
 
 	"github.com/omise/omise-go"
 	"github.com/omise/omise-go/internal/testutil"
 	"github.com/omise/omise-go/operations"
+	r "github.com/stretchr/testify/require"
 )
 
 func TestMain(m *testing.M) {
@@ -40,3 +42,71 @@ func createTestCharge(client *testutil.TestClient, token *omise.Token) *omise.Ch
 
 	return charge
 }
+
+func TestNoOp(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+
+	req, e := client.Request(&operations.NoOp{Path: "/account"})
+	r.NoError(t, e)
+	r.Equal(t, "GET", req.Method)
+	r.Equal(t, "https://api.omise.co/account", req.URL.String())
+	r.Empty(t, req.URL.RawQuery)
+
+	req, e = client.Request(&operations.NoOp{Method: "POST", Path: "/charges"})
+	r.NoError(t, e)
+	r.Equal(t, "POST", req.Method)
+
+	body, e := ioutil.ReadAll(req.Body)
+	r.NoError(t, e)
+	r.Empty(t, body)
+
+	account := &omise.Account{}
+	client.MustDo(account, &operations.NoOp{Path: "/account"})
+	r.NotEmpty(t, account.ID)
+}
+
+func TestDoBatch(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+
+	account, customer := &omise.Account{}, &omise.Customer{}
+	errs := operations.DoBatch(client.Client, []operations.Operation{
+		&operations.RetrieveAccount{},
+		&operations.RetrieveCustomer{CustomerID: "not_exist"},
+		&operations.NoOp{Path: "/account"},
+	}, []interface{}{account, customer})
+
+	r.Len(t, errs, 3)
+	r.NoError(t, errs[0])
+	r.NotEmpty(t, account.ID)
+
+	err, ok := errs[1].(*omise.Error)
+	r.True(t, ok)
+	r.Equal(t, 404, err.StatusCode)
+	r.Empty(t, customer.ID)
+
+	r.NoError(t, errs[2])
+}
+
+func TestOperations_PathEscape(t *testing.T) {
+	const ID = "id/../x?y#z"
+
+	client := testutil.NewFixedClient(t)
+	tests := []struct {
+		op   operations.Operation
+		path string
+	}{
+		{&operations.RetrieveSchedule{ScheduleID: ID}, "/schedules/id%2F..%2Fx%3Fy%23z"},
+		{&operations.DestroySchedule{ScheduleID: ID}, "/schedules/id%2F..%2Fx%3Fy%23z"},
+		{&operations.RetrieveCharge{ChargeID: ID}, "/charges/id%2F..%2Fx%3Fy%23z"},
+		{&operations.RetrieveCard{CustomerID: ID, CardID: ID}, "/customers/id%2F..%2Fx%3Fy%23z/cards/id%2F..%2Fx%3Fy%23z"},
+		{&operations.RetrieveToken{ID: ID}, "/tokens/id%2F..%2Fx%3Fy%23z"},
+	}
+
+	for _, test := range tests {
+		req, e := client.Request(test.op)
+		r.NoError(t, e)
+		r.Equal(t, test.path, req.URL.EscapedPath())
+		r.Empty(t, req.URL.RawQuery)
+		r.Empty(t, req.URL.Fragment)
+	}
+}
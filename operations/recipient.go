@@ -1,6 +1,8 @@
 package operations
 
 import (
+	"net/url"
+
 	"github.com/omise/omise-go"
 	"github.com/omise/omise-go/internal"
 )
@@ -22,9 +24,11 @@ type ListRecipients struct {
 
 func (req *ListRecipients) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/recipients",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/recipients",
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
 
@@ -81,9 +85,10 @@ type RetrieveRecipient struct {
 
 func (req *RetrieveRecipient) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/recipients/" + req.RecipientID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/recipients/" + url.PathEscape(req.RecipientID),
+		Retryable: true,
 	}
 }
 
@@ -113,7 +118,7 @@ func (req *UpdateRecipient) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "PATCH",
-		Path:     "/recipients/" + req.RecipientID,
+		Path:     "/recipients/" + url.PathEscape(req.RecipientID),
 	}
 }
 
@@ -134,6 +139,6 @@ func (req *DestroyRecipient) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "DELETE",
-		Path:     "/recipients/" + req.RecipientID,
+		Path:     "/recipients/" + url.PathEscape(req.RecipientID),
 	}
 }
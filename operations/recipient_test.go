@@ -26,6 +26,9 @@ func TestRecipient(t *testing.T) {
 	r.Equal(t, RecipientID, recipient.ID)
 	r.NotNil(t, recipient.BankAccount)
 	r.Equal(t, "6789", recipient.BankAccount.LastDigits)
+	r.Equal(t, "test", recipient.BankAccount.Brand)
+	r.Equal(t, "JOHN DOE", recipient.BankAccount.Name)
+	r.Equal(t, "normal", recipient.BankAccount.Type)
 
 	recipients := &omise.RecipientList{}
 	client.MustDo(recipients, &ListRecipients{})
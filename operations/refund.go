@@ -1,6 +1,8 @@
 package operations
 
 import (
+	"net/url"
+
 	"github.com/omise/omise-go/internal"
 )
 
@@ -20,9 +22,11 @@ type ListRefunds struct {
 
 func (req *ListRefunds) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/charges/" + req.ChargeID + "/refunds",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/charges/" + url.PathEscape(req.ChargeID) + "/refunds",
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
 
@@ -30,7 +34,7 @@ func (req *ListRefunds) Op() *internal.Op {
 //
 //	refund, create := &omise.Refund{}, &CreateRefund{
 //		ChargeID: charge.ID,
-//		Amount:   charge.Amount >> 1, // half
+//		Amount:   int64(charge.Amount) >> 1, // half
 //	}
 //	if e := client.Do(refund, create); e != nil {
 //		panic(e)
@@ -48,7 +52,7 @@ func (req *CreateRefund) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "POST",
-		Path:     "/charges/" + req.ChargeID + "/refunds",
+		Path:     "/charges/" + url.PathEscape(req.ChargeID) + "/refunds",
 	}
 }
 
@@ -71,8 +75,9 @@ type RetrieveRefund struct {
 
 func (req *RetrieveRefund) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/charges/" + req.ChargeID + "/refunds/" + req.RefundID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/charges/" + url.PathEscape(req.ChargeID) + "/refunds/" + url.PathEscape(req.RefundID),
+		Retryable: true,
 	}
 }
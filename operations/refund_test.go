@@ -28,7 +28,7 @@ func TestRefund(t *testing.T) {
 	refund = &omise.Refund{}
 	client.MustDo(refund, &CreateRefund{ChargeID, 10000, false})
 	r.Equal(t, RefundID, refund.ID)
-	r.Equal(t, int64(10000), refund.Amount)
+	r.Equal(t, omise.Amount(10000), refund.Amount)
 
 	e := client.Do(nil, &RetrieveRefund{ChargeID, "not_exist"})
 	r.Error(t, e)
@@ -58,7 +58,7 @@ func TestRefund_Network(t *testing.T) {
 	refund := &omise.Refund{}
 	client.MustDo(refund, &CreateRefund{
 		ChargeID: charge.ID,
-		Amount:   charge.Amount >> 1,
+		Amount:   int64(charge.Amount) >> 1,
 	})
 
 	r.Equal(t, refund.Amount, charge.Amount>>1)
@@ -1,8 +1,14 @@
 package operations
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
 	"net/url"
+	"sort"
+	"strings"
 	"time"
 
 	omise "github.com/omise/omise-go"
@@ -10,8 +16,67 @@ import (
 	"github.com/omise/omise-go/schedule"
 )
 
+// ErrAmbiguousChargeSource is returned when validating a CreateChargeSchedule that
+// specifies both a Card and a Source.
+var ErrAmbiguousChargeSource = errors.New("only one of card or source may be specified")
+
+// ErrAmbiguousStartDate is returned when validating a CreateChargeSchedule or a
+// CreateTransferSchedule that sets both StartDate and StartToday.
+var ErrAmbiguousStartDate = errors.New("only one of start date or start today may be specified")
+
+// ErrChargeCustomerRequired is returned when validating a CreateChargeSchedule without a
+// Customer.
+var ErrChargeCustomerRequired = errors.New("customer is required for charge schedules")
+
+// ErrChargeAmountRequired is returned when validating a CreateChargeSchedule with a zero
+// Amount that captures its charges. Zero amounts are only allowed with DontCapture.
+var ErrChargeAmountRequired = errors.New("amount is required unless charges are not captured")
+
+// ErrInvalidPercentageOfBalance is returned when validating a CreateTransferSchedule whose
+// PercentageOfBalance, once rounded, is not greater than 0 and at most 100.
+var ErrInvalidPercentageOfBalance = errors.New("percentage of balance must be greater than 0 and at most 100")
+
+// ErrNegativeChargeAmount is returned when validating a CreateChargeSchedule or a
+// CaptureCharge with a negative Amount.
+var ErrNegativeChargeAmount = errors.New("amount must not be negative")
+
 // CreateChargeSchedule represent create charge schedule API payload
 //
+// Each occurrence charges the Customer's Card or Source. Specify at most one of them; if
+// neither is given, no card is sent and Omise charges the customer's default card. Set Card
+// explicitly to guarantee which card a long-running schedule charges regardless of later
+// changes to the customer's cards.
+//
+// Customer is always required. Omise's REST API does not accept tokens on schedules since a
+// token can only be used once. To schedule charges against a tokenized card, e.g. for a
+// guest subscription, create a customer or attach the token to one with
+// AttachCardToCustomer and pass the resulting card as Card.
+//
+// Currency may be omitted, in which case Omise charges in the currency of the card being
+// charged. The resolved currency is reported back in the created Schedule's
+// Charge.Currency field.
+//
+// Amount is required unless DontCapture is set, in which case it may be zero to schedule
+// authorization-only card verification charges. As with CreateCharge, DontCapture is the
+// inverse of Omise's capture parameter so that the zero value matches the API default.
+//
+// Set StartToday to start the schedule on the current date in the account's timezone, as
+// resolved by Omise. Like an empty StartDate it leaves start_date out of the request, but
+// it makes the intent explicit and SimulateSchedule takes it as today in the client's
+// Location. Setting both StartToday and StartDate is an error.
+//
+// EndDate may be left empty for open-ended schedules, in which case end_date is left out of
+// the request rather than sent as a zero date. The same applies to CreateTransferSchedule.
+// Omise's REST API cannot extend the EndDate of an existing schedule or reactivate an
+// expired one. To renew, create a new schedule starting the day after the current EndDate.
+//
+// Omise's REST API does not offer per-schedule retry or fallback settings. A failed
+// occurrence is retried by Omise on its own, with the planned retry reported in the
+// Occurrence's RetryDate field.
+//
+// Metadata, if set, is sent along with the schedule and read back from the created
+// Schedule's Metadata field.
+//
 // Example:
 //
 //	schd, create := &omise.Schedule{}, &operations.CreateChargeSchedule{
@@ -36,6 +101,7 @@ type CreateChargeSchedule struct {
 	Every          int
 	Period         schedule.Period
 	StartDate      string
+	StartToday     bool
 	EndDate        string
 	Weekdays       schedule.Weekdays
 	DaysOfMonth    schedule.DaysOfMonth
@@ -45,7 +111,50 @@ type CreateChargeSchedule struct {
 	Amount      int
 	Currency    string
 	Card        string
+	Source      string
 	Description string
+	DontCapture bool
+
+	Metadata map[string]interface{}
+}
+
+// Clone returns a deep copy of the operation that shares no slices with the original, so
+// that copies can be modified and sent concurrently.
+func (req *CreateChargeSchedule) Clone() *CreateChargeSchedule {
+	clone := *req
+	clone.Weekdays = cloneWeekdays(req.Weekdays)
+	clone.DaysOfMonth = cloneDaysOfMonth(req.DaysOfMonth)
+	if req.Metadata != nil {
+		clone.Metadata = make(map[string]interface{}, len(req.Metadata))
+		for key, value := range req.Metadata {
+			clone.Metadata[key] = value
+		}
+	}
+	return &clone
+}
+
+// Validate checks that Customer is given, that at most one of Card or Source is given,
+// that Amount is positive, or zero when charges are not captured, and that Weekdays are
+// known. It is also called when the operation is marshaled.
+func (req *CreateChargeSchedule) Validate() error {
+	if e := req.Weekdays.Validate(); e != nil {
+		return e
+	}
+
+	switch {
+	case req.StartDate != "" && req.StartToday:
+		return ErrAmbiguousStartDate
+	case req.Customer == "":
+		return ErrChargeCustomerRequired
+	case req.Card != "" && req.Source != "":
+		return ErrAmbiguousChargeSource
+	case req.Amount < 0:
+		return ErrNegativeChargeAmount
+	case req.Amount == 0 && !req.DontCapture:
+		return ErrChargeAmountRequired
+	}
+
+	return nil
 }
 
 func (req *CreateChargeSchedule) MarshalJSON() ([]byte, error) {
@@ -54,23 +163,39 @@ func (req *CreateChargeSchedule) MarshalJSON() ([]byte, error) {
 		Amount      int    `json:"amount"`
 		Currency    string `json:"currency,omitempty"`
 		Card        string `json:"card,omitempty"`
+		Source      string `json:"source,omitempty"`
 		Description string `json:"description,omitempty"`
+		Capture     *bool  `json:"capture,omitempty"`
 	}
 
 	type on struct {
-		Weekdays       []schedule.Weekday `json:"weekdays,omitempty"`
-		DaysOfMonth    []int              `json:"days_of_month,omitempty"`
-		WeekdayOfMonth string             `json:"weekday_of_month,omitempty"`
+		Weekdays       schedule.Weekdays `json:"weekdays,omitempty"`
+		DaysOfMonth    []int             `json:"days_of_month,omitempty"`
+		WeekdayOfMonth string            `json:"weekday_of_month,omitempty"`
 	}
 
 	type param struct {
 		Every     int             `json:"every"`
 		Period    schedule.Period `json:"period"`
 		StartDate *omise.Date     `json:"start_date,omitempty"`
-		EndDate   omise.Date      `json:"end_date"`
+		EndDate   *omise.Date     `json:"end_date,omitempty"`
 		On        *on             `json:"on,omitempty"`
 
-		Charge charge `json:"charge"`
+		Charge   charge                 `json:"charge"`
+		Metadata map[string]interface{} `json:"metadata,omitempty"`
+	}
+
+	if e := req.Validate(); e != nil {
+		return nil, e
+	}
+
+	currency := req.Currency
+	if currency != "" {
+		normalized, err := omise.NormalizeCurrency(currency)
+		if err != nil {
+			return nil, err
+		}
+		currency = normalized
 	}
 
 	p := param{
@@ -79,10 +204,17 @@ func (req *CreateChargeSchedule) MarshalJSON() ([]byte, error) {
 		Charge: charge{
 			Customer:    req.Customer,
 			Amount:      req.Amount,
-			Currency:    req.Currency,
+			Currency:    currency,
 			Card:        req.Card,
+			Source:      req.Source,
 			Description: req.Description,
 		},
+		Metadata: req.Metadata,
+	}
+
+	if req.DontCapture {
+		capture := false
+		p.Charge.Capture = &capture
 	}
 
 	if req.StartDate != "" {
@@ -98,7 +230,7 @@ func (req *CreateChargeSchedule) MarshalJSON() ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		p.EndDate = omise.Date(endDate)
+		p.EndDate = (*omise.Date)(&endDate)
 	}
 
 	switch {
@@ -111,6 +243,9 @@ func (req *CreateChargeSchedule) MarshalJSON() ([]byte, error) {
 			DaysOfMonth: req.DaysOfMonth,
 		}
 	case p.Period == "month" && req.WeekdayOfMonth != "":
+		if err := schedule.ValidateWeekdayOfMonth(req.WeekdayOfMonth); err != nil {
+			return nil, err
+		}
 		p.On = &on{
 			WeekdayOfMonth: req.WeekdayOfMonth,
 		}
@@ -131,6 +266,12 @@ func (req *CreateChargeSchedule) Op() *internal.Op {
 
 // CreateTransferSchedule represent create transfer schedule API payload
 //
+// StartToday and EndDate behave as for CreateChargeSchedule.
+//
+// PercentageOfBalance is rounded to two decimal places, the precision accepted by Omise,
+// and must then be greater than 0 and at most 100. Leave it zero to transfer a fixed
+// Amount instead.
+//
 // Example:
 //
 //	schd, create := &omise.Schedule{}, &operations.CreateTransferSchedule{
@@ -155,6 +296,7 @@ type CreateTransferSchedule struct {
 	Every          int
 	Period         schedule.Period
 	StartDate      string
+	StartToday     bool
 	EndDate        string
 	Weekdays       schedule.Weekdays
 	DaysOfMonth    schedule.DaysOfMonth
@@ -165,6 +307,55 @@ type CreateTransferSchedule struct {
 	PercentageOfBalance float64
 }
 
+// Clone returns a deep copy of the operation that shares no slices with the original, so
+// that copies can be modified and sent concurrently.
+func (req *CreateTransferSchedule) Clone() *CreateTransferSchedule {
+	clone := *req
+	clone.Weekdays = cloneWeekdays(req.Weekdays)
+	clone.DaysOfMonth = cloneDaysOfMonth(req.DaysOfMonth)
+	return &clone
+}
+
+func cloneWeekdays(weekdays schedule.Weekdays) schedule.Weekdays {
+	if weekdays == nil {
+		return nil
+	}
+
+	return append(schedule.Weekdays{}, weekdays...)
+}
+
+func cloneDaysOfMonth(days schedule.DaysOfMonth) schedule.DaysOfMonth {
+	if days == nil {
+		return nil
+	}
+
+	return append(schedule.DaysOfMonth{}, days...)
+}
+
+// Validate checks that at most one of StartDate or StartToday is given, that
+// PercentageOfBalance, if given, is in range once rounded and that Weekdays are known. It
+// is also called when the operation is marshaled.
+func (req *CreateTransferSchedule) Validate() error {
+	if e := req.Weekdays.Validate(); e != nil {
+		return e
+	}
+
+	percentage := roundPercentage(req.PercentageOfBalance)
+
+	switch {
+	case req.StartDate != "" && req.StartToday:
+		return ErrAmbiguousStartDate
+	case req.PercentageOfBalance != 0 && (percentage <= 0 || percentage > 100):
+		return ErrInvalidPercentageOfBalance
+	}
+
+	return nil
+}
+
+func roundPercentage(percentage float64) float64 {
+	return math.Round(percentage*100) / 100
+}
+
 func (req *CreateTransferSchedule) MarshalJSON() ([]byte, error) {
 	type transfer struct {
 		Recipient           string  `json:"recipient"`
@@ -173,28 +364,32 @@ func (req *CreateTransferSchedule) MarshalJSON() ([]byte, error) {
 	}
 
 	type on struct {
-		Weekdays       []schedule.Weekday `json:"weekdays,omitempty"`
-		DaysOfMonth    []int              `json:"days_of_month,omitempty"`
-		WeekdayOfMonth string             `json:"weekday_of_month,omitempty"`
+		Weekdays       schedule.Weekdays `json:"weekdays,omitempty"`
+		DaysOfMonth    []int             `json:"days_of_month,omitempty"`
+		WeekdayOfMonth string            `json:"weekday_of_month,omitempty"`
 	}
 
 	type param struct {
 		Every     int             `json:"every"`
 		Period    schedule.Period `json:"period"`
 		StartDate *omise.Date     `json:"start_date,omitempty"`
-		EndDate   omise.Date      `json:"end_date"`
+		EndDate   *omise.Date     `json:"end_date,omitempty"`
 		On        *on             `json:"on,omitempty"`
 
 		Transfer transfer `json:"transfer"`
 	}
 
+	if e := req.Validate(); e != nil {
+		return nil, e
+	}
+
 	p := param{
 		Every:  req.Every,
 		Period: req.Period,
 		Transfer: transfer{
 			Recipient:           req.Recipient,
 			Amount:              req.Amount,
-			PercentageOfBalance: req.PercentageOfBalance,
+			PercentageOfBalance: roundPercentage(req.PercentageOfBalance),
 		},
 	}
 
@@ -211,7 +406,7 @@ func (req *CreateTransferSchedule) MarshalJSON() ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		p.EndDate = omise.Date(endDate)
+		p.EndDate = (*omise.Date)(&endDate)
 	}
 
 	switch {
@@ -224,6 +419,9 @@ func (req *CreateTransferSchedule) MarshalJSON() ([]byte, error) {
 			DaysOfMonth: req.DaysOfMonth,
 		}
 	case p.Period == "month" && req.WeekdayOfMonth != "":
+		if err := schedule.ValidateWeekdayOfMonth(req.WeekdayOfMonth); err != nil {
+			return nil, err
+		}
 		p.On = &on{
 			WeekdayOfMonth: req.WeekdayOfMonth,
 		}
@@ -271,12 +469,93 @@ func (req *ListSchedules) Op() *internal.Op {
 		Endpoint:    internal.API,
 		Method:      "GET",
 		Path:        "/schedules",
+		Retryable:   true,
+		Timeout:     req.Timeout,
 		ContentType: "application/json",
 	}
 }
 
+// CountSchedules returns the total number of schedules matching the given list filter
+// without fetching them all. The Limit of the filter is ignored.
+//
+// Example:
+//
+//	count, e := CountSchedules(client, List{From: time.Now().Add(-24 * time.Hour)})
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println("# of schedules made in the last day:", count)
+//
+func CountSchedules(client *omise.Client, filter List) (int, error) {
+	filter.Limit = 1
+
+	schds := &omise.ScheduleList{}
+	if e := client.Do(schds, &ListSchedules{filter}); e != nil {
+		return 0, e
+	}
+
+	return schds.Total, nil
+}
+
+// ListSchedulesByNextOccurrence returns up to limit active schedules ordered by their next
+// occurrence, soonest first. Omise's REST API can neither sort schedules by next occurrence
+// nor filter them by status, so every schedule is fetched with ListSchedules and sorted
+// client-side. Active schedules without upcoming occurrences are left out. A limit of zero
+// or less returns all of them.
+//
+// Example:
+//
+//	schds, e := ListSchedulesByNextOccurrence(ctx, client, 10)
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	for _, schd := range schds {
+//		next, _ := schd.NextOccurrenceDate()
+//		fmt.Println(schd.ID, "next bills on", next)
+//	}
+//
+func ListSchedulesByNextOccurrence(ctx context.Context, client *omise.Client, limit int) ([]*omise.Schedule, error) {
+	var result []*omise.Schedule
+
+	list := &ListSchedules{List{Limit: 100}}
+	for {
+		page := &omise.ScheduleList{}
+		if e := client.DoWithContext(ctx, page, list); e != nil {
+			return nil, e
+		}
+
+		for _, schd := range page.Data {
+			if _, ok := schd.NextOccurrenceDate(); ok && schd.Status == schedule.Active {
+				result = append(result, schd)
+			}
+		}
+
+		list.Offset += len(page.Data)
+		if len(page.Data) == 0 || list.Offset >= page.Total {
+			break
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, _ := result[i].NextOccurrenceDate()
+		b, _ := result[j].NextOccurrenceDate()
+		return a.Before(b)
+	})
+
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
 // RetrieveSchedule
 //
+// The retrieved schedule always embeds up to 30 NextOccurrences. Omise's API does not
+// offer a parameter to limit that number, so it cannot be capped per request.
+//
 // Example:
 //
 //	schd := &omise.Schedule{ID: "schd_57z9hj228pusa652nk1"}
@@ -292,20 +571,79 @@ type RetrieveSchedule struct {
 
 func (req *RetrieveSchedule) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/schedules/" + req.ScheduleID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/schedules/" + url.PathEscape(req.ScheduleID),
+		Retryable: true,
 	}
 }
 
+// RetrieveScheduleForCharge retrieves the schedule that created the given charge. Returns
+// a nil schedule and a nil error if the charge was not created by a schedule.
+//
+// Example:
+//
+//	schd, e := RetrieveScheduleForCharge(client, charge)
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	if schd != nil {
+//		fmt.Println("charge created by schedule:", schd.ID)
+//	}
+//
+func RetrieveScheduleForCharge(client *omise.Client, charge *omise.Charge) (*omise.Schedule, error) {
+	if charge.Schedule == "" {
+		return nil, nil
+	}
+
+	schd := &omise.Schedule{}
+	if e := client.Do(schd, &RetrieveSchedule{charge.Schedule}); e != nil {
+		return nil, e
+	}
+
+	return schd, nil
+}
+
+// RetrieveScheduleForTransfer retrieves the schedule that created the given transfer.
+// Returns a nil schedule and a nil error if the transfer was not created by a schedule.
+//
 // Example:
 //
-//	del, destroy := &omise.Schedule{}, &DestroySchedule{"recp-123"}
+//	schd, e := RetrieveScheduleForTransfer(client, transfer)
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	if schd != nil {
+//		fmt.Println("transfer created by schedule:", schd.ID)
+//	}
+//
+func RetrieveScheduleForTransfer(client *omise.Client, transfer *omise.Transfer) (*omise.Schedule, error) {
+	if transfer.Schedule == "" {
+		return nil, nil
+	}
+
+	schd := &omise.Schedule{}
+	if e := client.Do(schd, &RetrieveSchedule{transfer.Schedule}); e != nil {
+		return nil, e
+	}
+
+	return schd, nil
+}
+
+// DestroySchedule represent destroy schedule API payload
+//
+// Destroyed schedules stop creating occurrences and are returned with the Deleted status.
+//
+// Example:
+//
+//	del, destroy := &omise.Schedule{}, &DestroySchedule{"schd_57z9hj228pusa652nk1"}
 //	if e := client.Do(del, destroy); e != nil {
 //		panic(e)
 //	}
 //
-//	fmt.Println("destroyed recipient:", del.ID)
+//	fmt.Println("destroyed schedule:", del.ID)
 //
 type DestroySchedule struct {
 	ScheduleID string `query:"-"`
@@ -315,6 +653,420 @@ func (req *DestroySchedule) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "DELETE",
-		Path:     "/schedules/" + req.ScheduleID,
+		Path:     "/schedules/" + url.PathEscape(req.ScheduleID),
+	}
+}
+
+// ListScheduleOccurrences represent list schedule occurrences API payload
+//
+// Omise's REST API only lists occurrences per schedule; there is no account-wide
+// occurrence listing. To review occurrences across the account, page through
+// ListSchedules and call AllOccurrences for each schedule.
+//
+// Occurrences can be narrowed down to a date range with the From and To fields of List,
+// which bound the time each occurrence was processed. Filtering by status is not
+// supported by the API; use OccurrenceList.WithStatus on the retrieved page instead:
+//
+//	occurrences, list := &omise.OccurrenceList{}, ListScheduleOccurrences{
+//		ScheduleID: "schd_57z9hj228pusa652nk1",
+//	}.WithFrom(time.Now().AddDate(0, 0, -7))
+//	if e := client.Do(occurrences, list); e != nil {
+//		panic(e)
+//	}
+//
+//	failed := occurrences.WithStatus(schedule.OccurrenceFailed)
+//
+// Example:
+//
+//	occurrences, list := &omise.OccurrenceList{}, &ListScheduleOccurrences{
+//		ScheduleID: "schd_57z9hj228pusa652nk1",
+//	}
+//	if e := client.Do(occurrences, list); e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println("# of occurrences:", len(occurrences.Data))
+//
+type ListScheduleOccurrences struct {
+	ScheduleID string `query:"-"`
+	List
+}
+
+func (req *ListScheduleOccurrences) Op() *internal.Op {
+	return &internal.Op{
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/schedules/" + url.PathEscape(req.ScheduleID) + "/occurrences",
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
+
+// AllOccurrences pages through ListScheduleOccurrences until every occurrence of the
+// given schedule has been fetched and returns them as a single slice. Pages are fetched
+// with client.DoWithContext, so they are retried under the client's RetryPolicy and the
+// listing stops with ctx's error once ctx is done.
+//
+// Example:
+//
+//	occurrences, e := AllOccurrences(ctx, client, "schd_57z9hj228pusa652nk1")
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println("# of occurrences:", len(occurrences))
+//
+func AllOccurrences(ctx context.Context, client *omise.Client, scheduleID string) ([]omise.Occurrence, error) {
+	var result []omise.Occurrence
+
+	list := &ListScheduleOccurrences{
+		ScheduleID: scheduleID,
+		List:       List{Limit: 100},
+	}
+
+	for {
+		page := &omise.OccurrenceList{}
+		if e := client.DoWithContext(ctx, page, list); e != nil {
+			return nil, e
+		}
+
+		for _, occ := range page.Data {
+			result = append(result, *occ)
+		}
+		list.Offset += len(page.Data)
+		if len(page.Data) == 0 || list.Offset >= page.Total {
+			return result, nil
+		}
+	}
+}
+
+// ScheduleTransferIDs returns the IDs of the transfers created by the given transfer
+// schedule, in the order of its occurrences. Omise's REST API cannot filter ListTransfers by
+// schedule, so the IDs are collected from the schedule's occurrences with AllOccurrences.
+// Occurrences that did not create a transfer are left out.
+//
+// Example:
+//
+//	ids, e := ScheduleTransferIDs(ctx, client, "schd_57z9hj228pusa652nk2")
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println("# of transfers:", len(ids))
+//
+func ScheduleTransferIDs(ctx context.Context, client *omise.Client, scheduleID string) ([]string, error) {
+	occurrences, e := AllOccurrences(ctx, client, scheduleID)
+	if e != nil {
+		return nil, e
+	}
+
+	var ids []string
+	for _, occ := range occurrences {
+		if id := occ.TransferID(); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// ListCustomerSchedules represent list customer schedules API payload
+//
+// Example:
+//
+//	schds, list := &omise.ScheduleList{}, &ListCustomerSchedules{
+//		CustomerID: "cust_test_4yq6txdpfadhbaqnwp3",
+//	}
+//	if e := client.Do(schds, list); e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println("# of schedules:", len(schds.Data))
+//
+type ListCustomerSchedules struct {
+	CustomerID string `query:"-"`
+	List
+}
+
+func (req *ListCustomerSchedules) Op() *internal.Op {
+	return &internal.Op{
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/customers/" + url.PathEscape(req.CustomerID) + "/schedules",
+		Retryable: true,
+		Timeout:   req.Timeout,
+	}
+}
+
+// ScheduleErrors maps schedule IDs to the error encountered while operating on them.
+type ScheduleErrors map[string]error
+
+func (errs ScheduleErrors) Error() string {
+	ids := make([]string, 0, len(errs))
+	for id := range errs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	messages := make([]string, len(ids))
+	for i, id := range ids {
+		messages[i] = id + ": " + errs[id].Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// DestroyCustomerSchedules lists all schedules belonging to the given customer and
+// destroys each of them. The destroyed schedules are returned. Failing to destroy one
+// schedule does not stop the others from being destroyed; such failures are reported
+// together as a ScheduleErrors. Once ctx is done, the remaining schedules fail with ctx's
+// error.
+//
+// Example:
+//
+//	schds, e := DestroyCustomerSchedules(ctx, client, "cust_test_4yq6txdpfadhbaqnwp3")
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println("# of schedules destroyed:", len(schds))
+//
+func DestroyCustomerSchedules(ctx context.Context, client *omise.Client, customerID string) ([]*omise.Schedule, error) {
+	var targets []*omise.Schedule
+
+	list := &ListCustomerSchedules{
+		CustomerID: customerID,
+		List:       List{Limit: 100},
+	}
+
+	for {
+		page := &omise.ScheduleList{}
+		if e := client.DoWithContext(ctx, page, list); e != nil {
+			return nil, e
+		}
+
+		targets = append(targets, page.Data...)
+		list.Offset += len(page.Data)
+		if len(page.Data) == 0 || list.Offset >= page.Total {
+			break
+		}
+	}
+
+	var result []*omise.Schedule
+	errs := ScheduleErrors{}
+	for _, target := range targets {
+		schd := &omise.Schedule{}
+		if e := client.DoWithContext(ctx, schd, &DestroySchedule{target.ID}); e != nil {
+			errs[target.ID] = e
+			continue
+		}
+
+		result = append(result, schd)
+	}
+
+	if len(errs) > 0 {
+		return result, errs
+	}
+
+	return result, nil
+}
+
+// AwaitFirstOccurrenceInterval is the time CreateChargeScheduleAndAwaitFirst waits between
+// polls for the first occurrence.
+var AwaitFirstOccurrenceInterval = 5 * time.Second
+
+// CreateChargeScheduleAndAwaitFirst creates a charge schedule then polls its occurrences
+// until the first one is processed, i.e. is successful, failed or skipped. Polling stops
+// with the context's error if the context is done first, in which case the created
+// schedule is still returned.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+//	defer cancel()
+//
+//	schd, occ, e := CreateChargeScheduleAndAwaitFirst(ctx, client, create)
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println("first occurrence of", schd.ID, "is", occ.Status)
+//
+func CreateChargeScheduleAndAwaitFirst(ctx context.Context, client *omise.Client, req *CreateChargeSchedule) (*omise.Schedule, *omise.Occurrence, error) {
+	schd := &omise.Schedule{}
+	if e := client.DoWithContext(ctx, schd, req); e != nil {
+		return nil, nil, e
+	}
+
+	list := &ListScheduleOccurrences{
+		ScheduleID: schd.ID,
+		List:       List{Limit: 1, Order: omise.Chronological},
+	}
+
+	for {
+		occurrences := &omise.OccurrenceList{}
+		if e := client.DoWithContext(ctx, occurrences, list); e != nil {
+			return schd, nil, e
+		}
+
+		if len(occurrences.Data) > 0 {
+			switch occ := occurrences.Data[0]; occ.Status {
+			case schedule.OccurrenceSuccessful, schedule.OccurrenceFailed, schedule.OccurrenceSkip:
+				return schd, occ, nil
+			}
+		}
+
+		timer := time.NewTimer(AwaitFirstOccurrenceInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return schd, nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// ErrUnsupportedSimulation is returned by SimulateSchedule for operations other than
+// CreateChargeSchedule and CreateTransferSchedule.
+var ErrUnsupportedSimulation = errors.New("only schedule creation operations can be simulated")
+
+// SimulateSchedule validates a CreateChargeSchedule or CreateTransferSchedule operation as
+// it would be before being sent and returns up to limit dates the schedule would occur on,
+// without creating it. Omise's REST API has no dry-run endpoint so the dates are computed
+// on the client with schedule.Dates; see its documentation for caveats.
+//
+// With StartToday set, or StartDate left empty, the schedule starts today. Today is the
+// current date in client.Location, which should be set to the account's timezone to match
+// the date Omise would start the schedule on. If Location is nil, the local timezone of
+// the host is used instead.
+//
+// Example:
+//
+//	dates, e := SimulateSchedule(client, create, 12)
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	for _, date := range dates {
+//		fmt.Println("will charge on:", date.Format("2006-01-02"))
+//	}
+//
+func SimulateSchedule(client *omise.Client, req Operation, limit int) ([]time.Time, error) {
+	var (
+		every              int
+		period             schedule.Period
+		startDate, endDate string
+		startToday         bool
+		weekdays           schedule.Weekdays
+		daysOfMonth        schedule.DaysOfMonth
+		weekdayOfMonth     string
+	)
+
+	switch op := req.(type) {
+	case *CreateChargeSchedule:
+		every, period, startDate, endDate = op.Every, op.Period, op.StartDate, op.EndDate
+		startToday = op.StartToday
+		weekdays, daysOfMonth, weekdayOfMonth = op.Weekdays, op.DaysOfMonth, op.WeekdayOfMonth
+	case *CreateTransferSchedule:
+		every, period, startDate, endDate = op.Every, op.Period, op.StartDate, op.EndDate
+		startToday = op.StartToday
+		weekdays, daysOfMonth, weekdayOfMonth = op.Weekdays, op.DaysOfMonth, op.WeekdayOfMonth
+	default:
+		return nil, ErrUnsupportedSimulation
+	}
+
+	if _, e := json.Marshal(req); e != nil {
+		return nil, e
+	}
+
+	start, end := time.Now(), time.Time{}
+	if client.Location != nil {
+		start = start.In(client.Location)
+	}
+	if !startToday && startDate != "" {
+		start, _ = time.Parse("2006-01-02", startDate)
+	}
+	if endDate != "" {
+		end, _ = time.Parse("2006-01-02", endDate)
+	}
+
+	on := schedule.On{}
+	switch {
+	case period == schedule.PeriodWeek:
+		on.Weekdays = weekdays
+	case period == schedule.PeriodMonth && daysOfMonth != nil:
+		on.DaysOfMonth = daysOfMonth
+	case period == schedule.PeriodMonth && weekdayOfMonth != "":
+		on.WeekdayOfMonth = &weekdayOfMonth
+	}
+
+	return schedule.Dates(every, period, on, start, end, limit)
+}
+
+// DedupeMetadataKey is the metadata key under which CreateChargeScheduleIfNotExists stores
+// the dedupe key of the schedules it creates.
+const DedupeMetadataKey = "dedupe_key"
+
+// CreateChargeScheduleIfNotExists returns the customer's active schedule whose metadata
+// holds dedupeKey under DedupeMetadataKey, or creates one from req if there is none. The
+// created schedule carries dedupeKey in its metadata so that later calls, e.g. when a
+// webhook is retried, find it instead of creating a duplicate. req itself is not modified.
+//
+// The lookup pages through ListCustomerSchedules for req.Customer. The creation is also
+// sent with dedupeKey as its Idempotency-Key, so that it is retried under the client's
+// RetryPolicy and concurrent calls that both miss the lookup are deduplicated by Omise.
+//
+// Example:
+//
+//	schd, e := CreateChargeScheduleIfNotExists(client, create, "subscription-"+subscriptionID)
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println("schedule:", schd.ID)
+//
+func CreateChargeScheduleIfNotExists(client *omise.Client, req *CreateChargeSchedule, dedupeKey string) (*omise.Schedule, error) {
+	if e := req.Validate(); e != nil {
+		return nil, e
+	}
+
+	list := &ListCustomerSchedules{
+		CustomerID: req.Customer,
+		List:       List{Limit: 100},
+	}
+
+	for {
+		page := &omise.ScheduleList{}
+		if e := client.Do(page, list); e != nil {
+			return nil, e
+		}
+
+		for _, schd := range page.Data {
+			key, _ := schd.Metadata.GetString(DedupeMetadataKey)
+			if key == dedupeKey && schd.Status == schedule.Active {
+				return schd, nil
+			}
+		}
+
+		list.Offset += len(page.Data)
+		if len(page.Data) == 0 || list.Offset >= page.Total {
+			break
+		}
+	}
+
+	create := req.Clone()
+	if create.Metadata == nil {
+		create.Metadata = map[string]interface{}{}
+	}
+	create.Metadata[DedupeMetadataKey] = dedupeKey
+
+	header := http.Header{}
+	header.Set("Idempotency-Key", dedupeKey)
+
+	schd := &omise.Schedule{}
+	if e := client.DoWithHeader(schd, create, header); e != nil {
+		return nil, e
+	}
+
+	return schd, nil
+}
@@ -1,7 +1,12 @@
 package operations_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -28,6 +33,16 @@ func TestCreateChargeScheduleMarshal(t *testing.T) {
 			},
 			expected: `{"every":3,"period":"day","start_date":"2017-05-15","end_date":"2018-05-15","charge":{"customer":"customer_id","amount":100000}}`,
 		},
+		{
+			req: &CreateChargeSchedule{
+				Every:     1,
+				Period:    schedule.PeriodDay,
+				StartDate: "2017-05-15",
+				Customer:  "customer_id",
+				Amount:    100000,
+			},
+			expected: `{"every":1,"period":"day","start_date":"2017-05-15","charge":{"customer":"customer_id","amount":100000}}`,
+		},
 		{
 			req: &CreateChargeSchedule{
 				Every:  3,
@@ -67,6 +82,30 @@ func TestCreateChargeScheduleMarshal(t *testing.T) {
 			},
 			expected: `{"every":3,"period":"month","start_date":"2017-05-15","end_date":"2018-05-15","on":{"weekday_of_month":"last_thursday"},"charge":{"customer":"customer_id","amount":100000}}`,
 		},
+		{
+			req: &CreateChargeSchedule{
+				Every:     1,
+				Period:    schedule.PeriodMonth,
+				StartDate: "2017-05-15",
+				EndDate:   "2018-05-15",
+				Customer:  "customer_id",
+				Amount:    100000,
+				Source:    "src_123",
+			},
+			expected: `{"every":1,"period":"month","start_date":"2017-05-15","end_date":"2018-05-15","charge":{"customer":"customer_id","amount":100000,"source":"src_123"}}`,
+		},
+		{
+			req: &CreateChargeSchedule{
+				Every:     1,
+				Period:    schedule.PeriodMonth,
+				StartDate: "2017-05-15",
+				EndDate:   "2018-05-15",
+				Customer:  "customer_id",
+				Amount:    100000,
+				Card:      "card_123",
+			},
+			expected: `{"every":1,"period":"month","start_date":"2017-05-15","end_date":"2018-05-15","charge":{"customer":"customer_id","amount":100000,"card":"card_123"}}`,
+		},
 	}
 
 	for _, td := range testdata {
@@ -76,6 +115,193 @@ func TestCreateChargeScheduleMarshal(t *testing.T) {
 	}
 }
 
+func TestCreateChargeScheduleMarshal_Weekdays(t *testing.T) {
+	b, err := json.Marshal(&CreateChargeSchedule{
+		Every:  1,
+		Period: schedule.PeriodWeek,
+		Weekdays: schedule.Weekdays{
+			schedule.Saturday,
+			schedule.Monday,
+			schedule.Saturday,
+			schedule.Monday,
+		},
+		StartDate: "2017-05-15",
+		EndDate:   "2018-05-15",
+		Customer:  "customer_id",
+		Amount:    100000,
+	})
+	r.NoError(t, err)
+	r.Contains(t, string(b), `"on":{"weekdays":["monday","saturday"]}`)
+
+	charge := &CreateChargeSchedule{
+		Every:    1,
+		Period:   schedule.PeriodWeek,
+		Weekdays: schedule.Weekdays{schedule.Monday, "funday"},
+		Customer: "customer_id",
+		Amount:   100000,
+	}
+	r.Equal(t, schedule.ErrInvalidWeekday("funday"), charge.Validate())
+
+	_, err = json.Marshal(charge)
+	r.Error(t, err)
+	r.Contains(t, err.Error(), "invalid weekday: funday")
+
+	transfer := &CreateTransferSchedule{
+		Every:     1,
+		Period:    schedule.PeriodWeek,
+		Weekdays:  schedule.Weekdays{"funday"},
+		Recipient: "recipient_id",
+		Amount:    100000,
+	}
+	r.Equal(t, schedule.ErrInvalidWeekday("funday"), transfer.Validate())
+}
+
+func TestCreateScheduleMarshal_WeekdayOfMonth(t *testing.T) {
+	_, err := json.Marshal(&CreateChargeSchedule{
+		Every:          1,
+		Period:         schedule.PeriodMonth,
+		WeekdayOfMonth: "last_thurs",
+		Customer:       "customer_id",
+		Amount:         100000,
+	})
+	r.Error(t, err)
+	r.Contains(t, err.Error(), "invalid weekday of month: last_thurs")
+
+	_, err = json.Marshal(&CreateTransferSchedule{
+		Every:          1,
+		Period:         schedule.PeriodMonth,
+		WeekdayOfMonth: "5th_monday",
+		Recipient:      "recipient_id",
+		Amount:         100000,
+	})
+	r.Error(t, err)
+
+	r.NoError(t, schedule.ValidateWeekdayOfMonth("1st_monday"))
+	r.NoError(t, schedule.ValidateWeekdayOfMonth("2nd_monday"))
+	r.NoError(t, schedule.ValidateWeekdayOfMonth("last_sunday"))
+	r.Error(t, schedule.ValidateWeekdayOfMonth(""))
+	r.Error(t, schedule.ValidateWeekdayOfMonth("last_Sunday"))
+	r.Error(t, schedule.ValidateWeekdayOfMonth("second_monday"))
+}
+
+func TestCreateChargeScheduleMarshal_Currency(t *testing.T) {
+	b, err := json.Marshal(&CreateChargeSchedule{
+		Every:    1,
+		Period:   schedule.PeriodDay,
+		EndDate:  "2018-05-15",
+		Customer: "customer_id",
+		Amount:   100000,
+		Currency: "THB",
+	})
+	r.NoError(t, err)
+	r.Contains(t, string(b), `"currency":"thb"`)
+
+	_, err = json.Marshal(&CreateChargeSchedule{
+		Every:    1,
+		Period:   schedule.PeriodDay,
+		Customer: "customer_id",
+		Amount:   100000,
+		Currency: "bath",
+	})
+	r.Error(t, err)
+	r.Contains(t, err.Error(), "invalid currency: bath")
+}
+
+func TestCreateChargeScheduleMarshal_AmbiguousSource(t *testing.T) {
+	_, err := json.Marshal(&CreateChargeSchedule{
+		Every:    1,
+		Period:   schedule.PeriodDay,
+		Customer: "customer_id",
+		Amount:   100000,
+		Card:     "card_123",
+		Source:   "src_123",
+	})
+	r.True(t, errors.Is(err, ErrAmbiguousChargeSource))
+}
+
+func TestCreateScheduleMarshal_StartToday(t *testing.T) {
+	charge := &CreateChargeSchedule{
+		Every:      1,
+		Period:     schedule.PeriodDay,
+		StartToday: true,
+		Customer:   "customer_id",
+		Amount:     100000,
+	}
+
+	b, err := json.Marshal(charge)
+	r.NoError(t, err)
+	r.JSONEq(t, `{"every":1,"period":"day","charge":{"customer":"customer_id","amount":100000}}`, string(b))
+
+	charge.StartDate = "2017-05-15"
+	r.Equal(t, ErrAmbiguousStartDate, charge.Validate())
+
+	client := testutil.NewFixedClient(t)
+	_, err = client.Request(charge)
+	r.Equal(t, ErrAmbiguousStartDate, err)
+
+	transfer := &CreateTransferSchedule{
+		Every:      1,
+		Period:     schedule.PeriodDay,
+		StartToday: true,
+		Recipient:  "recipient_id",
+		Amount:     100000,
+	}
+
+	b, err = json.Marshal(transfer)
+	r.NoError(t, err)
+	r.JSONEq(t, `{"every":1,"period":"day","transfer":{"recipient":"recipient_id","amount":100000}}`, string(b))
+
+	transfer.StartDate = "2017-05-15"
+	r.Equal(t, ErrAmbiguousStartDate, transfer.Validate())
+
+	_, err = json.Marshal(transfer)
+	r.True(t, errors.Is(err, ErrAmbiguousStartDate))
+
+	_, err = client.Request(transfer)
+	r.Equal(t, ErrAmbiguousStartDate, err)
+}
+
+func TestCreateChargeScheduleMarshal_CustomerRequired(t *testing.T) {
+	_, err := json.Marshal(&CreateChargeSchedule{
+		Every:  1,
+		Period: schedule.PeriodDay,
+		Amount: 100000,
+		Card:   "tokn_test_5086xl7c9k5rnx35qba",
+	})
+	r.True(t, errors.Is(err, ErrChargeCustomerRequired))
+}
+
+func TestCreateChargeScheduleMarshal_Amount(t *testing.T) {
+	req := &CreateChargeSchedule{
+		Every:    1,
+		Period:   schedule.PeriodDay,
+		Customer: "customer_id",
+	}
+
+	_, err := json.Marshal(req)
+	r.True(t, errors.Is(err, ErrChargeAmountRequired))
+	r.Equal(t, ErrChargeAmountRequired, req.Validate())
+
+	_, err = testutil.NewFixedClient(t).Request(req)
+	r.Equal(t, ErrChargeAmountRequired, err)
+
+	req.Amount = -1
+	req.DontCapture = true
+	_, err = json.Marshal(req)
+	r.True(t, errors.Is(err, ErrNegativeChargeAmount))
+
+	req.Amount = 0
+	b, err := json.Marshal(req)
+	r.NoError(t, err)
+	r.Contains(t, string(b), `"charge":{"customer":"customer_id","amount":0,"capture":false}`)
+
+	req.Amount = 100000
+	req.DontCapture = false
+	b, err = json.Marshal(req)
+	r.NoError(t, err)
+	r.NotContains(t, string(b), `"capture"`)
+}
+
 func TestCreateChargeSchedule_Network(t *testing.T) {
 	// CustomerID must have this customer in test server
 	const CustomerID = `cust_57z9e1nce0wvbbkvef1`
@@ -112,6 +338,16 @@ func TestCreateTransferScheduleMarshal(t *testing.T) {
 			},
 			expected: `{"every":3,"period":"day","start_date":"2017-05-15","end_date":"2018-05-15","transfer":{"recipient":"recipient_id","amount":100000}}`,
 		},
+		{
+			req: &CreateTransferSchedule{
+				Every:     1,
+				Period:    schedule.PeriodDay,
+				StartDate: "2017-05-15",
+				Recipient: "recipient_id",
+				Amount:    100000,
+			},
+			expected: `{"every":1,"period":"day","start_date":"2017-05-15","transfer":{"recipient":"recipient_id","amount":100000}}`,
+		},
 		{
 			req: &CreateTransferSchedule{
 				Every:  3,
@@ -163,6 +399,36 @@ func TestCreateTransferScheduleMarshal(t *testing.T) {
 	}
 }
 
+func TestCreateTransferScheduleMarshal_PercentageOfBalance(t *testing.T) {
+	req := &CreateTransferSchedule{
+		Every:               1,
+		Period:              schedule.PeriodMonth,
+		DaysOfMonth:         schedule.DaysOfMonth{1},
+		Recipient:           "recp_123",
+		PercentageOfBalance: 33.333333333,
+	}
+
+	b, err := json.Marshal(req)
+	r.NoError(t, err)
+	r.Contains(t, string(b), `"percentage_of_balance":33.33`)
+	r.NotContains(t, string(b), `33.333`)
+
+	req.PercentageOfBalance = 20.349999
+	b, err = json.Marshal(req)
+	r.NoError(t, err)
+	r.Contains(t, string(b), `"percentage_of_balance":20.35`)
+
+	for _, percentage := range []float64{-1, 0.001, 100.01} {
+		req.PercentageOfBalance = percentage
+		_, err = json.Marshal(req)
+		r.True(t, errors.Is(err, ErrInvalidPercentageOfBalance), "%v", percentage)
+	}
+
+	req.PercentageOfBalance = 100
+	_, err = json.Marshal(req)
+	r.NoError(t, err)
+}
+
 func TestCreateTransferSchedule_Network(t *testing.T) {
 	// RecipientID must have this recipient in test server
 	const RecipientID = `recp_57z9e1nce0wvbbkvef1`
@@ -192,8 +458,14 @@ func TestCreateSchedule(t *testing.T) {
 	client := testutil.NewFixedClient(t)
 
 	schd := &omise.Schedule{}
-	client.MustDo(schd, &CreateChargeSchedule{})
+	client.MustDo(schd, &CreateChargeSchedule{
+		Every:    3,
+		Period:   schedule.PeriodDay,
+		Customer: "cust_57z9e1nce0wvbbkvef1",
+		Amount:   100000,
+	})
 	r.Equal(t, ScheduleID, schd.ID)
+	r.Equal(t, "thb", schd.Charge.Currency)
 }
 
 func TestListSchedule(t *testing.T) {
@@ -202,6 +474,9 @@ func TestListSchedule(t *testing.T) {
 	client.MustDo(schds, &ListSchedules{})
 
 	r.Len(t, schds.Data, 2)
+	r.Equal(t, "1970-01-01T07:00:00+07:00", schds.From)
+	r.Equal(t, "2017-05-16T14:32:24+07:00", schds.To)
+	r.Equal(t, omise.Chronological, schds.Order)
 
 	r.Equal(t, "schd_57zhl296uxc7yiun6xa", schds.Data[0].ID)
 	r.NotNil(t, schds.Data[0].Charge)
@@ -212,6 +487,22 @@ func TestListSchedule(t *testing.T) {
 	r.Nil(t, schds.Data[1].Charge)
 }
 
+func TestCountSchedules(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+
+	var body []byte
+	fixtures := client.Transport
+	client.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ = ioutil.ReadAll(req.Body)
+		return fixtures.RoundTrip(req)
+	})
+
+	count, e := CountSchedules(client.Client, List{Limit: 100})
+	r.NoError(t, e)
+	r.Equal(t, 2, count)
+	r.Equal(t, `{"limit":1}`, string(body))
+}
+
 func TestListSchedules_Network(t *testing.T) {
 	testutil.Require(t, "network")
 	client := testutil.NewTestClient(t)
@@ -227,27 +518,145 @@ func TestListSchedules_Network(t *testing.T) {
 	t.Logf("%#v\n", schds)
 }
 
+func TestListSchedulesByNextOccurrence(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+	client.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"object":"list","offset":0,"limit":100,"total":4,"data":[
+			{"object":"schedule","id":"schd_later","status":"active","next_occurrences":["2017-06-05","2017-06-10"]},
+			{"object":"schedule","id":"schd_expired","status":"expired","next_occurrences":["2017-05-16"]},
+			{"object":"schedule","id":"schd_sooner","status":"active","next_occurrences":["2017-05-20","2017-06-05"]},
+			{"object":"schedule","id":"schd_done","status":"active","next_occurrences":[]}
+		]}`
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	})
+
+	schds, e := ListSchedulesByNextOccurrence(context.Background(), client.Client, 0)
+	r.NoError(t, e)
+	r.Len(t, schds, 2)
+	r.Equal(t, "schd_sooner", schds[0].ID)
+	r.Equal(t, "schd_later", schds[1].ID)
+
+	schds, e = ListSchedulesByNextOccurrence(context.Background(), client.Client, 1)
+	r.NoError(t, e)
+	r.Len(t, schds, 1)
+	r.Equal(t, "schd_sooner", schds[0].ID)
+}
+
 func TestRetrieveSchedule(t *testing.T) {
 	ScheduleID := "schd_57z9hj228pusa652nk1"
 
 	client := testutil.NewFixedClient(t)
 	schd := &omise.Schedule{}
 	client.MustDo(schd, &RetrieveSchedule{ScheduleID})
+	r.Equal(t, "schedule", schd.Object)
 	r.Equal(t, ScheduleID, schd.ID)
 	r.Nil(t, schd.Transfer)
-	r.Equal(t, 100000, schd.Charge.Amount)
+	r.Equal(t, omise.Amount(100000), schd.Charge.Amount)
+	r.Equal(t, "thb", schd.Charge.Currency)
+	r.Equal(t, "cust_57z9e1nce0wvbbkvef1", schd.Charge.Customer)
+	r.Equal(t, "card_57z9e1nce0wvbbkvef2", *schd.Charge.Card)
+	r.Equal(t, "Membership fee", schd.Charge.Description)
 	r.Equal(t, schedule.Active, schd.Status)
+	r.Equal(t, "Every 3 day(s)", schd.InWords)
+	r.Equal(t, time.Date(2018, 5, 15, 0, 0, 0, 0, time.UTC), time.Time(schd.EndDate))
+	r.False(t, schd.Ended())
+	r.True(t, (&omise.Schedule{Status: schedule.Expired}).Ended())
+	r.Equal(t, time.Date(2017, 5, 15, 17, 35, 1, 0, time.UTC), schd.Created.UTC())
 	r.Len(t, schd.NextOccurrences, 30)
 
+	amount, ok := schd.ChargeAmount()
+	r.True(t, ok)
+	r.Equal(t, omise.Amount(100000), amount)
+
+	major, ok := schd.ChargeAmountMajor()
+	r.True(t, ok)
+	r.Equal(t, 1000.0, major)
+	_, ok = schd.TransferAmount()
+	r.False(t, ok)
+
+	next, ok := schd.NextOccurrenceDate()
+	r.True(t, ok)
+	r.Equal(t, time.Date(2017, 5, 15, 0, 0, 0, 0, time.UTC), next)
+
+	_, ok = (&omise.Schedule{}).NextOccurrenceDate()
+	r.False(t, ok)
+
 	ScheduleID = "schd_57z9hj228pusa652nk2"
 
 	schd = &omise.Schedule{}
 	client.MustDo(schd, &RetrieveSchedule{ScheduleID})
 	r.Equal(t, ScheduleID, schd.ID)
 	r.Nil(t, schd.Charge)
-	r.Equal(t, 100000, *schd.Transfer.Amount)
+	r.Equal(t, omise.Amount(100000), *schd.Transfer.Amount)
+	r.Equal(t, "thb", schd.Transfer.Currency)
+	r.Equal(t, "recp_57z9e1nce0wvbbkvef1", schd.Transfer.Recipient)
+	r.Nil(t, schd.Transfer.PercentageOfBalance)
 	r.Equal(t, schedule.Active, schd.Status)
 	r.Len(t, schd.NextOccurrences, 30)
+
+	amount, ok = schd.TransferAmount()
+	r.True(t, ok)
+	r.Equal(t, omise.Amount(100000), amount)
+	_, ok = schd.ChargeAmount()
+	r.False(t, ok)
+}
+
+func TestRetrieveScheduleForCharge(t *testing.T) {
+	const (
+		ScheduleID = "schd_57z9hj228pusa652nk1"
+	)
+
+	client := testutil.NewFixedClient(t)
+
+	charge := &omise.Charge{}
+	e := json.Unmarshal([]byte(`{"object":"charge","schedule":"`+ScheduleID+`"}`), charge)
+	r.NoError(t, e)
+	r.Equal(t, ScheduleID, charge.Schedule)
+
+	schd, e := RetrieveScheduleForCharge(client.Client, charge)
+	r.NoError(t, e)
+	r.Equal(t, ScheduleID, schd.ID)
+
+	schd, e = RetrieveScheduleForCharge(client.Client, &omise.Charge{})
+	r.NoError(t, e)
+	r.Nil(t, schd)
+}
+
+func TestRetrieveScheduleForTransfer(t *testing.T) {
+	const (
+		ScheduleID = "schd_57z9hj228pusa652nk2"
+	)
+
+	client := testutil.NewFixedClient(t)
+
+	transfer := &omise.Transfer{}
+	e := json.Unmarshal([]byte(`{"object":"transfer","schedule":"`+ScheduleID+`"}`), transfer)
+	r.NoError(t, e)
+	r.Equal(t, ScheduleID, transfer.Schedule)
+
+	schd, e := RetrieveScheduleForTransfer(client.Client, transfer)
+	r.NoError(t, e)
+	r.Equal(t, ScheduleID, schd.ID)
+
+	schd, e = RetrieveScheduleForTransfer(client.Client, &omise.Transfer{})
+	r.NoError(t, e)
+	r.Nil(t, schd)
+}
+
+func TestScheduleTransferIDs(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+
+	ids, e := ScheduleTransferIDs(context.Background(), client.Client, "schd_57z9hj228pusa652nk2")
+	r.NoError(t, e)
+	r.Equal(t, []string{"trsf_test_4yqacz8t3cbipcj766u"}, ids)
+
+	ids, e = ScheduleTransferIDs(context.Background(), client.Client, "schd_57z9hj228pusa652nk1")
+	r.NoError(t, e)
+	r.Empty(t, ids)
 }
 
 func TestRetrieveSchedule_Network(t *testing.T) {
@@ -270,7 +679,7 @@ func TestDestroySchedule(t *testing.T) {
 	client.MustDo(schd, &DestroySchedule{ScheduleID})
 	r.Equal(t, ScheduleID, schd.ID)
 	r.Nil(t, schd.Transfer)
-	r.Equal(t, 100000, schd.Charge.Amount)
+	r.Equal(t, omise.Amount(100000), schd.Charge.Amount)
 	r.Equal(t, schedule.Deleted, schd.Status)
 	r.Len(t, schd.NextOccurrences, 30)
 
@@ -280,7 +689,7 @@ func TestDestroySchedule(t *testing.T) {
 	client.MustDo(schd, &DestroySchedule{ScheduleID})
 	r.Equal(t, ScheduleID, schd.ID)
 	r.Nil(t, schd.Charge)
-	r.Equal(t, 100000, *schd.Transfer.Amount)
+	r.Equal(t, omise.Amount(100000), *schd.Transfer.Amount)
 	r.Equal(t, schedule.Deleted, schd.Status)
 	r.Len(t, schd.NextOccurrences, 30)
 }
@@ -296,3 +705,228 @@ func TestDestroySchedule_Network(t *testing.T) {
 
 	t.Logf("%#v\n", schd)
 }
+
+func TestListScheduleOccurrences(t *testing.T) {
+	const (
+		ScheduleID = "schd_57z9hj228pusa652nk1"
+	)
+
+	client := testutil.NewFixedClient(t)
+	occurrences := &omise.OccurrenceList{}
+	client.MustDo(occurrences, &ListScheduleOccurrences{ScheduleID: ScheduleID})
+
+	r.Equal(t, "list", occurrences.Object)
+	r.Len(t, occurrences.Data, 2)
+	r.Equal(t, "occurrence", occurrences.Data[0].Object)
+	r.Equal(t, "occu_57z9hj228pusa652nk1", occurrences.Data[0].ID)
+	r.Equal(t, ScheduleID, occurrences.Data[0].Schedule)
+	r.Equal(t, schedule.OccurrenceSuccessful, occurrences.Data[0].Status)
+	r.Equal(t, schedule.OccurrenceFailed, occurrences.Data[1].Status)
+	r.Equal(t, "chrg_57z9hj228pusa652nk2", occurrences.Data[1].ChargeID())
+
+	failed := occurrences.WithStatus(schedule.OccurrenceFailed)
+	r.Len(t, failed, 1)
+	r.Equal(t, "occu_57z9hj228pusa652nk2", failed[0].ID)
+	r.Empty(t, occurrences.WithStatus(schedule.OccurrenceSkip))
+
+	from := time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC)
+	req, e := client.Request(ListScheduleOccurrences{ScheduleID: ScheduleID}.WithFrom(from))
+	r.NoError(t, e)
+
+	body, e := ioutil.ReadAll(req.Body)
+	r.NoError(t, e)
+	r.JSONEq(t, `{"from":"2017-05-01T00:00:00Z"}`, string(body))
+}
+
+func TestAllOccurrences(t *testing.T) {
+	const (
+		ScheduleID = "schd_57z9hj228pusa652nk1"
+	)
+
+	client := testutil.NewFixedClient(t)
+	occurrences, e := AllOccurrences(context.Background(), client.Client, ScheduleID)
+	r.NoError(t, e)
+	r.Len(t, occurrences, 2)
+	r.Equal(t, "occu_57z9hj228pusa652nk1", occurrences[0].ID)
+	r.Equal(t, "occu_57z9hj228pusa652nk2", occurrences[1].ID)
+
+	_, e = AllOccurrences(context.Background(), client.Client, "not_exist")
+	r.Error(t, e)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, e = AllOccurrences(ctx, client.Client, ScheduleID)
+	r.True(t, errors.Is(e, context.Canceled))
+}
+
+func TestListCustomerSchedules(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+	schds := &omise.ScheduleList{}
+	client.MustDo(schds, &ListCustomerSchedules{CustomerID: "cust_test_4yq6txdpfadhbaqnwp3"})
+
+	r.Len(t, schds.Data, 3)
+	r.Equal(t, "schd_57z9hj228pusa652nk1", schds.Data[0].ID)
+}
+
+func TestDestroyCustomerSchedules(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+	schds, e := DestroyCustomerSchedules(context.Background(), client.Client, "cust_test_4yq6txdpfadhbaqnwp3")
+
+	r.Len(t, schds, 2)
+	r.Equal(t, "schd_57z9hj228pusa652nk1", schds[0].ID)
+	r.Equal(t, "schd_57z9hj228pusa652nk2", schds[1].ID)
+
+	errs, ok := e.(ScheduleErrors)
+	r.True(t, ok)
+	r.Len(t, errs, 1)
+	r.Contains(t, errs, "schd_missing")
+	r.Contains(t, e.Error(), "schd_missing: ")
+}
+
+func TestCreateChargeScheduleAndAwaitFirst(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+	create := &CreateChargeSchedule{
+		Every:    3,
+		Period:   schedule.PeriodDay,
+		Customer: "cust_57z9e1nce0wvbbkvef1",
+		Amount:   100000,
+	}
+
+	schd, occ, e := CreateChargeScheduleAndAwaitFirst(context.Background(), client.Client, create)
+	r.NoError(t, e)
+	r.Equal(t, "schd_57z9hj228pusa652nk1", schd.ID)
+	r.Equal(t, "occu_57z9hj228pusa652nk1", occ.ID)
+	r.Equal(t, schedule.OccurrenceSuccessful, occ.Status)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	schd, occ, e = CreateChargeScheduleAndAwaitFirst(ctx, client.Client, create)
+	r.True(t, errors.Is(e, context.Canceled))
+	r.Nil(t, schd)
+	r.Nil(t, occ)
+}
+
+func TestSimulateSchedule(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+
+	dates, e := SimulateSchedule(client.Client, &CreateChargeSchedule{
+		Every:     1,
+		Period:    schedule.PeriodWeek,
+		Weekdays:  schedule.Weekdays{schedule.Monday},
+		StartDate: "2017-05-15",
+		EndDate:   "2017-05-31",
+		Customer:  "customer_id",
+		Amount:    100000,
+	}, 10)
+	r.NoError(t, e)
+	r.Equal(t, []time.Time{
+		time.Date(2017, 5, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2017, 5, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(2017, 5, 29, 0, 0, 0, 0, time.UTC),
+	}, dates)
+
+	dates, e = SimulateSchedule(client.Client, &CreateTransferSchedule{
+		Every:       1,
+		Period:      schedule.PeriodMonth,
+		DaysOfMonth: schedule.DaysOfMonth{1},
+		StartDate:   "2017-05-15",
+		Recipient:   "recp_123",
+		Amount:      100000,
+	}, 2)
+	r.NoError(t, e)
+	r.Equal(t, []time.Time{
+		time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2017, 7, 1, 0, 0, 0, 0, time.UTC),
+	}, dates)
+
+	_, e = SimulateSchedule(client.Client, &CreateChargeSchedule{Every: 1, Period: schedule.PeriodDay}, 10)
+	r.True(t, errors.Is(e, ErrChargeCustomerRequired))
+
+	_, e = SimulateSchedule(client.Client, &ListSchedules{}, 10)
+	r.Equal(t, ErrUnsupportedSimulation, e)
+
+	// today is taken in the client's Location
+	client.Location = time.FixedZone("UTC+14", 14*60*60)
+	now := time.Now().In(client.Location)
+	dates, e = SimulateSchedule(client.Client, &CreateChargeSchedule{
+		Every:      1,
+		Period:     schedule.PeriodDay,
+		StartToday: true,
+		Customer:   "customer_id",
+		Amount:     100000,
+	}, 1)
+	r.NoError(t, e)
+	r.Equal(t, []time.Time{time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)}, dates)
+}
+
+func TestCreateSchedule_Clone(t *testing.T) {
+	charge := &CreateChargeSchedule{
+		Every:    1,
+		Period:   schedule.PeriodWeek,
+		Weekdays: schedule.Weekdays{schedule.Monday},
+		Customer: "customer_id",
+		Amount:   100000,
+	}
+
+	clone := charge.Clone()
+	r.Equal(t, charge, clone)
+
+	clone.Weekdays[0] = schedule.Friday
+	clone.Customer = "other_customer_id"
+	r.Equal(t, schedule.Monday, charge.Weekdays[0])
+	r.Equal(t, "customer_id", charge.Customer)
+	r.Nil(t, clone.DaysOfMonth)
+
+	transfer := &CreateTransferSchedule{
+		Every:       1,
+		Period:      schedule.PeriodMonth,
+		DaysOfMonth: schedule.DaysOfMonth{1, 15},
+		Recipient:   "recp_123",
+		Amount:      100000,
+	}
+
+	transferClone := transfer.Clone()
+	r.Equal(t, transfer, transferClone)
+
+	transferClone.DaysOfMonth[0] = 2
+	r.Equal(t, 1, transfer.DaysOfMonth[0])
+}
+
+func TestCreateChargeScheduleIfNotExists(t *testing.T) {
+	client := testutil.NewFixedClient(t)
+
+	var methods, keys []string
+	var body []byte
+	fixtures := client.Transport
+	client.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		methods = append(methods, req.Method)
+		if req.Method == "POST" {
+			keys = append(keys, req.Header.Get("Idempotency-Key"))
+			body, _ = ioutil.ReadAll(req.Body)
+		}
+		return fixtures.RoundTrip(req)
+	})
+
+	create := &CreateChargeSchedule{
+		Every:    3,
+		Period:   schedule.PeriodDay,
+		Customer: "cust_test_4yq6txdpfadhbaqnwp3",
+		Amount:   100000,
+	}
+
+	// an active schedule with the same key already exists
+	schd, e := CreateChargeScheduleIfNotExists(client.Client, create, "subscription-123")
+	r.NoError(t, e)
+	r.Equal(t, "schd_57z9hj228pusa652nk1", schd.ID)
+	r.Equal(t, []string{"GET"}, methods)
+
+	// no schedule has the key yet
+	methods = nil
+	schd, e = CreateChargeScheduleIfNotExists(client.Client, create, "subscription-456")
+	r.NoError(t, e)
+	r.Equal(t, "schd_57z9hj228pusa652nk1", schd.ID)
+	r.Equal(t, []string{"GET", "POST"}, methods)
+	r.Equal(t, []string{"subscription-456"}, keys)
+	r.Contains(t, string(body), `"metadata":{"dedupe_key":"subscription-456"}`)
+	r.Nil(t, create.Metadata)
+}
@@ -24,8 +24,9 @@ type Search struct {
 
 func (req *Search) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/search",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/search",
+		Retryable: true,
 	}
 }
@@ -45,7 +45,7 @@ func TestSearch_Network(t *testing.T) {
 
 	charge := result.Data[0]
 	r.Equal(t, ChargeID, charge.ID)
-	r.Equal(t, int64(100000), charge.Amount)
+	r.Equal(t, omise.Amount(100000), charge.Amount)
 
 	// using filters
 	result = &omise.ChargeSearchResult{}
@@ -59,5 +59,5 @@ func TestSearch_Network(t *testing.T) {
 
 	charge = result.Data[0]
 	r.Equal(t, ChargeID, charge.ID)
-	r.Equal(t, int64(100000), charge.Amount)
+	r.Equal(t, omise.Amount(100000), charge.Amount)
 }
@@ -1,6 +1,7 @@
 package operations
 
 import (
+	"net/url"
 	"time"
 
 	"github.com/omise/omise-go/internal"
@@ -58,8 +59,9 @@ type RetrieveToken struct {
 
 func (token *RetrieveToken) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.Vault,
-		Method:   "GET",
-		Path:     "/tokens/" + token.ID,
+		Endpoint:  internal.Vault,
+		Method:    "GET",
+		Path:      "/tokens/" + url.PathEscape(token.ID),
+		Retryable: true,
 	}
 }
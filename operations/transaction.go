@@ -1,19 +1,21 @@
 package operations
 
 import (
+	"net/url"
+
 	"github.com/omise/omise-go/internal"
 )
 
 // Example:
 //
-//	transactions, list := &omise.TransactionList{}, &ListTransaction{
+//	transactions, list := &omise.TransactionList{}, &ListTransactions{
 //		List{
 //			Limit: 100,
 //			From: time.Now().Add(-1 * time.Hour),
 //		},
 //	}
-//	if e := client.Do(transactions, list); !a.NoError(t, e) {
-//		return
+//	if e := client.Do(transactions, list); e != nil {
+//		panic(e)
 //	}
 //
 //	fmt.Println("# of transactions in the last hour:", len(transactions.Data))
@@ -24,17 +26,17 @@ type ListTransactions struct {
 
 func (req *ListTransactions) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/transactions",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/transactions",
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
 
 // Example:
 //
 //	transaction, retrieve := &omise.Transaction{}, &RetrieveTransaction{"trxn_987"}
-//		TransactionID: transactions.Data[0].ID,
-//	}
 //	if e := client.Do(transaction, retrieve); e != nil {
 //		panic(e)
 //	}
@@ -47,8 +49,9 @@ type RetrieveTransaction struct {
 
 func (req *RetrieveTransaction) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/transactions/" + req.TransactionID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/transactions/" + url.PathEscape(req.TransactionID),
+		Retryable: true,
 	}
 }
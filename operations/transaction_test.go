@@ -21,6 +21,9 @@ func TestTransaction(t *testing.T) {
 	tx := &omise.Transaction{}
 	client.MustDo(tx, &RetrieveTransaction{TransactionID})
 	r.Equal(t, TransactionID, tx.ID)
+	r.Equal(t, omise.Credit, tx.Type)
+	r.Equal(t, omise.Amount(96094), tx.Amount)
+	r.Equal(t, "THB", tx.Currency)
 
 	transactions := &omise.TransactionList{}
 	client.MustDo(transactions, &ListTransactions{})
@@ -1,6 +1,8 @@
 package operations
 
 import (
+	"net/url"
+
 	"github.com/omise/omise-go/internal"
 )
 
@@ -24,9 +26,11 @@ type ListTransfers struct {
 
 func (req *ListTransfers) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/transfers",
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/transfers",
+		Retryable: true,
+		Timeout:   req.Timeout,
 	}
 }
 
@@ -69,9 +73,10 @@ type RetrieveTransfer struct {
 
 func (req *RetrieveTransfer) Op() *internal.Op {
 	return &internal.Op{
-		Endpoint: internal.API,
-		Method:   "GET",
-		Path:     "/transfers/" + req.TransferID,
+		Endpoint:  internal.API,
+		Method:    "GET",
+		Path:      "/transfers/" + url.PathEscape(req.TransferID),
+		Retryable: true,
 	}
 }
 
@@ -96,7 +101,7 @@ func (req *UpdateTransfer) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "PATCH",
-		Path:     "/transfers/" + req.TransferID,
+		Path:     "/transfers/" + url.PathEscape(req.TransferID),
 	}
 }
 
@@ -117,6 +122,6 @@ func (req *DestroyTransfer) Op() *internal.Op {
 	return &internal.Op{
 		Endpoint: internal.API,
 		Method:   "DELETE",
-		Path:     "/transfers/" + req.TransferID,
+		Path:     "/transfers/" + url.PathEscape(req.TransferID),
 	}
 }
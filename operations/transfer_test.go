@@ -21,7 +21,7 @@ func TestTransfer(t *testing.T) {
 	transfer := &omise.Transfer{}
 	client.MustDo(transfer, &CreateTransfer{Amount: 192188})
 	r.Equal(t, TransferID, transfer.ID)
-	r.Equal(t, int64(192188), transfer.Amount)
+	r.Equal(t, omise.Amount(192188), transfer.Amount)
 
 	transfer = &omise.Transfer{}
 	client.MustDo(transfer, &RetrieveTransfer{TransferID})
@@ -36,7 +36,7 @@ func TestTransfer(t *testing.T) {
 		Amount:     192189,
 	})
 	r.Equal(t, TransferID, transfer.ID)
-	r.Equal(t, int64(192189), transfer.Amount)
+	r.Equal(t, omise.Amount(192189), transfer.Amount)
 
 	del := &omise.Deletion{}
 	client.MustDo(del, &DestroyTransfer{TransferID})
@@ -52,7 +52,7 @@ func TestTransfer_Network(t *testing.T) {
 	transfer := &omise.Transfer{}
 	client.MustDo(transfer, &CreateTransfer{Amount: 32100})
 
-	r.Equal(t, int64(32100), transfer.Amount)
+	r.Equal(t, omise.Amount(32100), transfer.Amount)
 	r.NotNil(t, transfer.BankAccount)
 
 	// gets created transfer
@@ -81,7 +81,7 @@ func TestTransfer_Network(t *testing.T) {
 	})
 
 	r.Equal(t, transfer.ID, transfer2.ID)
-	r.Equal(t, int64(12300), transfer2.Amount)
+	r.Equal(t, omise.Amount(12300), transfer2.Amount)
 
 	// destroy transfer
 	del, destroy := &omise.Deletion{}, &DestroyTransfer{TransferID: transfer.ID}
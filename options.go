@@ -0,0 +1,60 @@
+package omise
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/omise/omise-go/internal"
+)
+
+// Option configures a Client created with NewClient. Most options set one of the Client's
+// exported fields, which may also be changed directly after construction.
+type Option func(*Client)
+
+// WithHTTPClient makes the Client send requests through the given *http.Client instead of
+// the default one, e.g. to use a custom transport or timeout.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.Client = client
+	}
+}
+
+// WithAPIVersion sets the Omise API version sent with every request.
+func WithAPIVersion(version string) Option {
+	return func(c *Client) {
+		c.APIVersion = version
+	}
+}
+
+// WithUserAgentSuffix appends the given product token, such as "myapp/1.0", to the
+// User-Agent sent with every request.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(c *Client) {
+		c.UserAgentSuffix = suffix
+	}
+}
+
+// WithBaseContext sets the context every request of the Client is derived from. See the
+// Client's BaseContext field.
+func WithBaseContext(ctx context.Context) Option {
+	return func(c *Client) {
+		c.BaseContext = ctx
+	}
+}
+
+// WithEndpoint overrides the URL of one of the Omise endpoints, APIEndpoint or
+// VaultEndpoint.
+func WithEndpoint(endpoint internal.Endpoint, url string) Option {
+	return func(c *Client) {
+		c.Endpoints[endpoint] = url
+	}
+}
+
+// Sandbox makes NewClient return ErrLiveKey if either key is a live key. Omise has no
+// separate sandbox host: test mode is selected by using test keys against the regular
+// endpoints, and this option guarantees that the client is in test mode.
+func Sandbox() Option {
+	return func(c *Client) {
+		c.sandbox = true
+	}
+}
@@ -0,0 +1,47 @@
+package omise
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimit holds the rate limit budget reported by Omise in the X-RateLimit-Limit,
+// X-RateLimit-Remaining and X-RateLimit-Reset response headers. Fields whose header was
+// not sent are left zero.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// LastRateLimit returns the rate limit budget reported with the most recent response
+// received by the client. The second return value is false if no response has carried an
+// X-RateLimit-Remaining header yet. Omise does not document these headers, so they may not
+// be sent at all.
+func (c *Client) LastRateLimit() (RateLimit, bool) {
+	c.rateLimitMutex.Lock()
+	defer c.rateLimitMutex.Unlock()
+
+	return c.rateLimit, c.rateLimitSeen
+}
+
+func (c *Client) recordRateLimit(header http.Header) {
+	remaining, e := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if e != nil {
+		return
+	}
+
+	limit := RateLimit{Remaining: remaining}
+	if n, e := strconv.Atoi(header.Get("X-RateLimit-Limit")); e == nil {
+		limit.Limit = n
+	}
+	if n, e := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); e == nil {
+		limit.Reset = time.Unix(n, 0)
+	}
+
+	c.rateLimitMutex.Lock()
+	defer c.rateLimitMutex.Unlock()
+
+	c.rateLimit, c.rateLimitSeen = limit, true
+}
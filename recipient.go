@@ -2,6 +2,9 @@ package omise
 
 // Recipient represents Omise's recipient object.
 // See https://www.omise.co/recipients-api for more information.
+//
+// Check Verified and Active, along with the BankAccount details, before scheduling
+// transfers to a recipient.
 type Recipient struct {
 	Base
 	Verified    bool          `json:"verified"`
@@ -4,8 +4,10 @@ package omise
 // See https://www.omise.co/refunds-api for more information.
 type Refund struct {
 	Base
-	Amount      int64  `json:"amount" pretty:""`
+	Amount      Amount `json:"amount" pretty:""`
 	Currency    string `json:"currency" pretty:""`
 	Charge      string `json:"charge" pretty:""`
 	Transaction string `json:"transaction"`
+
+	Metadata Metadata `json:"metadata"`
 }
@@ -0,0 +1,36 @@
+package omise
+
+import (
+	"strings"
+)
+
+var resourceTypes = map[string]string{
+	"acct": "account",
+	"card": "card",
+	"chrg": "charge",
+	"cust": "customer",
+	"dspt": "dispute",
+	"evnt": "event",
+	"link": "link",
+	"occu": "occurrence",
+	"recp": "recipient",
+	"rfnd": "refund",
+	"schd": "schedule",
+	"src":  "source",
+	"tokn": "token",
+	"trsf": "transfer",
+	"trxn": "transaction",
+}
+
+// ResourceType returns the object type, as found in the Object field of the decoded
+// resource, that the given Omise ID refers to. For example, ResourceType("schd_test_1")
+// returns "schedule". The second return value is false if the ID prefix is not known.
+func ResourceType(id string) (string, bool) {
+	i := strings.Index(id, "_")
+	if i <= 0 {
+		return "", false
+	}
+
+	resourceType, ok := resourceTypes[id[:i]]
+	return resourceType, ok
+}
@@ -0,0 +1,32 @@
+package omise_test
+
+import (
+	"testing"
+
+	. "github.com/omise/omise-go"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestResourceType(t *testing.T) {
+	tests := []struct {
+		id           string
+		resourceType string
+		ok           bool
+	}{
+		{"schd_57z9hj228pusa652nk1", "schedule", true},
+		{"cust_test_4yq6txdpfadhbaqnwp3", "customer", true},
+		{"chrg_test_4yq7duw15p9hdrjp8oq", "charge", true},
+		{"occu_57z9hj228pusa652nk1", "occurrence", true},
+		{"src_test_59trf2nxk43b5nml8z0", "source", true},
+		{"zzzz_123", "", false},
+		{"_123", "", false},
+		{"chrg", "", false},
+		{"", "", false},
+	}
+
+	for _, test := range tests {
+		resourceType, ok := ResourceType(test.id)
+		r.Equal(t, test.ok, ok, test.id)
+		r.Equal(t, test.resourceType, resourceType, test.id)
+	}
+}
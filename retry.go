@@ -0,0 +1,49 @@
+package omise
+
+import "time"
+
+// maxBackoff caps the wait time between retries once doubled.
+const maxBackoff = 5 * time.Minute
+
+// RetryPolicy configures how the client retries operations that failed for a transient
+// reason, such as a network error, a rate limit (HTTP 429) or a server error (HTTP 5xx).
+// Only operations marked as retryable, or sent with an Idempotency-Key header, are retried.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+
+	// Backoff is the time to wait before the first retry. The wait time is doubled after
+	// each subsequent retry, up to five minutes.
+	Backoff time.Duration
+
+	// MaxElapsed, if non-zero, caps the total time spent on an operation across all
+	// attempts, regardless of MaxRetries. Attempts still in flight when it runs out are
+	// aborted and no retry is made whose backoff would exceed it.
+	MaxElapsed time.Duration
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.Backoff
+	for i := 0; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > maxBackoff && p.Backoff <= maxBackoff {
+		backoff = maxBackoff
+	}
+
+	return backoff
+}
+
+func isTransient(e error) bool {
+	switch err := e.(type) {
+	case *OperationError:
+		return true
+	case *Error:
+		return err.StatusCode == 429 || err.StatusCode >= 500
+	case *DecodeError:
+		return err.StatusCode == 429 || err.StatusCode >= 500
+	}
+
+	return false
+}
@@ -0,0 +1,19 @@
+package omise
+
+import (
+	"testing"
+	"time"
+
+	r "github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{Backoff: time.Second}
+	r.Equal(t, time.Second, policy.backoff(0))
+	r.Equal(t, 4*time.Second, policy.backoff(2))
+	r.Equal(t, maxBackoff, policy.backoff(10))
+	r.Equal(t, maxBackoff, policy.backoff(100))
+
+	policy = &RetryPolicy{Backoff: time.Hour}
+	r.Equal(t, time.Hour, policy.backoff(100))
+}
@@ -1,9 +1,33 @@
 package omise
 
-import "github.com/omise/omise-go/schedule"
+import (
+	"time"
+
+	"github.com/omise/omise-go/schedule"
+)
 
 // Schedule represents Omise's schedule object.
 // See https://www.omise.co/schedule-api for more information.
+//
+// Only one of Charge or Transfer is set depending on the kind of schedule. Use the
+// ChargeAmount and TransferAmount accessors to read amounts without nil checks.
+//
+// On holds the decoded recurrence of the schedule, i.e. the weekdays, days of month or
+// weekday of month it runs on along with Every and Period. It mirrors the On field of the
+// operations used to create schedules and is empty for daily schedules.
+//
+// InWords holds the server-rendered human description of the schedule, such as
+// "Every 3 weeks on Monday and Saturday", suitable for display to end users.
+//
+// NextOccurrences holds up to 30 upcoming dates but is empty, or nil if Omise left it out,
+// for schedules that will not run again such as deleted or expired ones. Do not index it
+// without checking its length; NextOccurrenceDate does so for the next date.
+//
+// Metadata holds the metadata given when the schedule was created, if any. Omise's REST API
+// cannot update a schedule, its metadata included; destroy it and create a new one instead.
+//
+// Schedules carry no account or team identifiers. When aggregating schedules retrieved
+// with different keys, retrieve the Account with the same client to tell them apart.
 type Schedule struct {
 	Base
 	Status          schedule.Status          `json:"status"`
@@ -17,4 +41,94 @@ type Schedule struct {
 	Transfer        *schedule.TransferDetail `json:"transfer"`
 	Occurrences     OccurrenceList           `json:"occurrences"`
 	NextOccurrences []Date                   `json:"next_occurrences"`
+	Metadata        Metadata                 `json:"metadata"`
+}
+
+// Ended returns true if the schedule has run past its EndDate and will not create any
+// more occurrences. EndDate holds the end date as stored by Omise.
+func (s *Schedule) Ended() bool {
+	return s.Status == schedule.Expired
+}
+
+// ChargeAmount returns the amount charged on each occurrence. The second return value is
+// false if this is not a charge schedule.
+func (s *Schedule) ChargeAmount() (Amount, bool) {
+	if s.Charge == nil {
+		return 0, false
+	}
+
+	return s.Charge.Amount, true
+}
+
+// ChargeAmountMajor returns the amount charged on each occurrence in major units of the
+// charge's currency, e.g. 1000.00 for an amount of 100000 in "thb". The second return value
+// is false if this is not a charge schedule or its currency is unknown.
+func (s *Schedule) ChargeAmountMajor() (float64, bool) {
+	if s.Charge == nil {
+		return 0, false
+	}
+
+	amount, e := ToMajorUnits(int64(s.Charge.Amount), s.Charge.Currency)
+	if e != nil {
+		return 0, false
+	}
+
+	return amount, true
+}
+
+// TransferAmount returns the fixed amount transferred on each occurrence. The second
+// return value is false if this is not a transfer schedule or if the transfer is
+// specified as a percentage of balance instead.
+func (s *Schedule) TransferAmount() (Amount, bool) {
+	if s.Transfer == nil || s.Transfer.Amount == nil {
+		return 0, false
+	}
+
+	return *s.Transfer.Amount, true
+}
+
+// NextOccurrenceDate returns the date of the next occurrence of the schedule, i.e. the
+// earliest date in NextOccurrences as computed by Omise when the schedule was retrieved.
+// The second return value is false if there are no upcoming occurrences, for example on
+// an expired or deleted schedule.
+func (s *Schedule) NextOccurrenceDate() (time.Time, bool) {
+	if len(s.NextOccurrences) == 0 {
+		return time.Time{}, false
+	}
+
+	next := time.Time(s.NextOccurrences[0])
+	for _, date := range s.NextOccurrences[1:] {
+		if t := time.Time(date); t.Before(next) {
+			next = t
+		}
+	}
+
+	return next, true
+}
+
+// OccurrencesSummary counts the occurrences of a schedule by outcome.
+type OccurrencesSummary struct {
+	Total      int
+	Successful int
+	Failed     int
+}
+
+// OccurrencesSummary summarizes the occurrences of the schedule from its embedded
+// Occurrences list, without further requests. Omise does not send any count or summary
+// fields on schedules, but the embedded list reports the total number of occurrences and
+// holds the most recent ones. The second return value is false if the list does not hold
+// every occurrence, in which case only Total is accurate; use AllOccurrences from the
+// operations package to count the rest.
+func (s *Schedule) OccurrencesSummary() (OccurrencesSummary, bool) {
+	summary := OccurrencesSummary{Total: s.Occurrences.Total}
+	for _, occ := range s.Occurrences.Data {
+		switch occ.Status {
+		case schedule.OccurrenceSuccessful:
+			summary.Successful++
+		case schedule.OccurrenceFailed:
+			summary.Failed++
+		}
+	}
+
+	return summary, len(s.Occurrences.Data) >= s.Occurrences.Total
 }
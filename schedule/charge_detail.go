@@ -1,10 +1,15 @@
 package schedule
 
-// ChargeDetail represents charge detail for schedule object.
+import "github.com/omise/omise-go/internal"
+
+// ChargeDetail represents charge detail for schedule object. Amount is always present on
+// charge schedules and so is not a pointer, unlike TransferDetail.Amount.
+// Currency is always the resolved currency, even if none was specified when the schedule
+// was created.
 type ChargeDetail struct {
-	Amount      int     `json:"amount"`
-	Currency    string  `json:"currency"`
-	Customer    string  `json:"customer"`
-	Card        *string `json:"card"`
-	Description string  `json:"description"`
+	Amount      internal.Amount `json:"amount"`
+	Currency    string          `json:"currency"`
+	Customer    string          `json:"customer"`
+	Card        *string         `json:"card"`
+	Description string          `json:"description"`
 }
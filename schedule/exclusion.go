@@ -0,0 +1,44 @@
+package schedule
+
+import (
+	"time"
+)
+
+// SkipExcludedDates returns startDate, or if it is one of the excluded dates, the first
+// following day that is not excluded. Dates are in the "2006-01-02" format used by the
+// StartDate field of schedule operations.
+//
+// Omise's REST API does not support excluding dates, such as bank holidays, from a
+// schedule. This helper only adjusts the start date; later occurrences of the schedule
+// may still fall on excluded dates.
+//
+// Example:
+//
+//	start, e := schedule.SkipExcludedDates("2017-12-31", []string{"2017-12-31", "2018-01-01"})
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	fmt.Println(start) // 2018-01-02
+//
+func SkipExcludedDates(startDate string, excluded []string) (string, error) {
+	date, e := time.Parse("2006-01-02", startDate)
+	if e != nil {
+		return "", e
+	}
+
+	skip := map[string]bool{}
+	for _, exclusion := range excluded {
+		if _, e := time.Parse("2006-01-02", exclusion); e != nil {
+			return "", e
+		}
+
+		skip[exclusion] = true
+	}
+
+	for skip[date.Format("2006-01-02")] {
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return date.Format("2006-01-02"), nil
+}
@@ -0,0 +1,29 @@
+package schedule_test
+
+import (
+	"testing"
+
+	. "github.com/omise/omise-go/schedule"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestSkipExcludedDates(t *testing.T) {
+	holidays := []string{"2017-12-31", "2018-01-01", "2018-01-03"}
+
+	start, e := SkipExcludedDates("2017-12-30", holidays)
+	r.NoError(t, e)
+	r.Equal(t, "2017-12-30", start)
+
+	start, e = SkipExcludedDates("2017-12-31", holidays)
+	r.NoError(t, e)
+	r.Equal(t, "2018-01-02", start)
+
+	start, e = SkipExcludedDates("2018-01-03", nil)
+	r.NoError(t, e)
+	r.Equal(t, "2018-01-03", start)
+
+	_, e = SkipExcludedDates("2017-12-32", holidays)
+	r.Error(t, e)
+	_, e = SkipExcludedDates("2017-12-30", []string{"tomorrow"})
+	r.Error(t, e)
+}
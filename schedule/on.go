@@ -1,11 +1,64 @@
 package schedule
 
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+)
+
 // DaysOfMonth represents slice of day of month
 type DaysOfMonth []int
 
 // Weekdays represents slice of weekday
 type Weekdays []Weekday
 
+// MarshalJSON dedupes and sorts the weekdays from Monday to Sunday so the output is
+// deterministic. Weekdays unknown to this package, e.g. ones decoded from an API
+// response, are kept after the known ones so that decoded schedules can be re-encoded.
+// Operations that create schedules call Validate to reject them instead.
+func (w Weekdays) MarshalJSON() ([]byte, error) {
+	if w == nil {
+		return []byte("null"), nil
+	}
+
+	seen, result := map[Weekday]bool{}, []string{}
+	for _, day := range w {
+		if seen[day] {
+			continue
+		}
+
+		seen[day] = true
+		result = append(result, string(day))
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return weekdayRank(Weekday(result[i])) < weekdayRank(Weekday(result[j]))
+	})
+
+	return json.Marshal(result)
+}
+
+// Validate returns an ErrInvalidWeekday for the first weekday that is not one of the
+// predefined constants.
+func (w Weekdays) Validate() error {
+	for _, day := range w {
+		if _, ok := weekdayOrder[day]; !ok {
+			return ErrInvalidWeekday(day)
+		}
+	}
+
+	return nil
+}
+
+func weekdayRank(day Weekday) int {
+	if order, ok := weekdayOrder[day]; ok {
+		return order
+	}
+
+	return len(weekdayOrder)
+}
+
 // WeekDay represents set of weekday
 type Weekday string
 
@@ -19,6 +72,115 @@ const (
 	Sunday    Weekday = "sunday"
 )
 
+var weekdayOrder = map[Weekday]int{
+	Monday:    0,
+	Tuesday:   1,
+	Wednesday: 2,
+	Thursday:  3,
+	Friday:    4,
+	Saturday:  5,
+	Sunday:    6,
+}
+
+func (d Weekday) String() string {
+	return string(d)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Weekday) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler so that weekdays can be loaded from
+// configuration formats such as YAML. The text is lowercased but not validated, so that
+// decoding API responses never fails on a weekday unknown to this package. Use
+// ParseWeekday to reject unknown values when loading configuration.
+func (d *Weekday) UnmarshalText(text []byte) error {
+	*d = Weekday(strings.ToLower(string(text)))
+	return nil
+}
+
+// ParseWeekday returns the Weekday named by s, matched case-insensitively, or an
+// ErrInvalidWeekday if it is not one of the predefined constants.
+func ParseWeekday(s string) (Weekday, error) {
+	day := Weekday(strings.ToLower(s))
+	if _, ok := weekdayOrder[day]; !ok {
+		return "", ErrInvalidWeekday(s)
+	}
+
+	return day, nil
+}
+
+var timeWeekdays = map[time.Weekday]Weekday{
+	time.Monday:    Monday,
+	time.Tuesday:   Tuesday,
+	time.Wednesday: Wednesday,
+	time.Thursday:  Thursday,
+	time.Friday:    Friday,
+	time.Saturday:  Saturday,
+	time.Sunday:    Sunday,
+}
+
+// FromTimeWeekday returns the Weekday corresponding to the given time.Weekday, e.g.
+// Monday for time.Monday. It returns an empty Weekday for values outside of
+// time.Sunday to time.Saturday.
+func FromTimeWeekday(day time.Weekday) Weekday {
+	return timeWeekdays[day]
+}
+
+// ToTimeWeekday returns the time.Weekday corresponding to the Weekday, or an
+// ErrInvalidWeekday if it is not one of the predefined constants.
+func (d Weekday) ToTimeWeekday() (time.Weekday, error) {
+	for day, weekday := range timeWeekdays {
+		if weekday == d {
+			return day, nil
+		}
+	}
+
+	return 0, ErrInvalidWeekday(d)
+}
+
+// ErrInvalidWeekday is returned when validating a Weekday that is not one of the
+// predefined constants.
+type ErrInvalidWeekday Weekday
+
+func (e ErrInvalidWeekday) Error() string {
+	return "invalid weekday: " + string(e)
+}
+
+// ErrInvalidWeekdayOfMonth is returned when marshaling a weekday of month that is not
+// recognized by the Omise API, e.g. "last_thurs" instead of "last_thursday".
+type ErrInvalidWeekdayOfMonth string
+
+func (e ErrInvalidWeekdayOfMonth) Error() string {
+	return "invalid weekday of month: " + string(e)
+}
+
+var weekdayOfMonthOrdinals = []string{"1st", "2nd", "3rd", "4th", "last"}
+
+// weekdaysOfMonth is the allow-list of weekday of month values, such as "2nd_monday"
+// or "last_friday".
+var weekdaysOfMonth = map[string]bool{}
+
+func init() {
+	for _, ordinal := range weekdayOfMonthOrdinals {
+		for day := range weekdayOrder {
+			weekdaysOfMonth[ordinal+"_"+string(day)] = true
+		}
+	}
+}
+
+// ValidateWeekdayOfMonth returns an ErrInvalidWeekdayOfMonth if value is not a valid
+// weekday of month, i.e. one of 1st, 2nd, 3rd, 4th or last followed by an
+// underscore and a Weekday.
+func ValidateWeekdayOfMonth(value string) error {
+	if !weekdaysOfMonth[value] {
+		return ErrInvalidWeekdayOfMonth(value)
+	}
+
+	return nil
+}
+
 // On represents on field of Schedule object.
 type On struct {
 	Weekdays       Weekdays    `json:"weekdays"`
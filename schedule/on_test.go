@@ -0,0 +1,107 @@
+package schedule_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/omise/omise-go/schedule"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestWeekday_TimeWeekday(t *testing.T) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		weekday := FromTimeWeekday(day)
+		r.NotEqual(t, Weekday(""), weekday)
+
+		back, e := weekday.ToTimeWeekday()
+		r.NoError(t, e)
+		r.Equal(t, day, back)
+	}
+
+	r.Equal(t, Monday, FromTimeWeekday(time.Monday))
+	r.Equal(t, Sunday, FromTimeWeekday(time.Date(2017, 5, 14, 0, 0, 0, 0, time.UTC).Weekday()))
+	r.Equal(t, Weekday(""), FromTimeWeekday(time.Weekday(7)))
+
+	_, e := Weekday("mon").ToTimeWeekday()
+	r.Equal(t, ErrInvalidWeekday("mon"), e)
+}
+
+func TestOn_UnmarshalJSON(t *testing.T) {
+	on := On{}
+	r.NoError(t, json.Unmarshal([]byte(`{"weekdays":["monday","saturday"]}`), &on))
+	r.Equal(t, Weekdays{Monday, Saturday}, on.Weekdays)
+	r.Nil(t, on.DaysOfMonth)
+	r.Nil(t, on.WeekdayOfMonth)
+
+	on = On{}
+	r.NoError(t, json.Unmarshal([]byte(`{"days_of_month":[1,15]}`), &on))
+	r.Equal(t, DaysOfMonth{1, 15}, on.DaysOfMonth)
+
+	on = On{}
+	r.NoError(t, json.Unmarshal([]byte(`{"weekday_of_month":"2nd_monday"}`), &on))
+	r.Equal(t, "2nd_monday", *on.WeekdayOfMonth)
+
+	on = On{}
+	r.NoError(t, json.Unmarshal([]byte(`{}`), &on))
+	r.Equal(t, On{}, on)
+}
+
+func TestWeekday_Text(t *testing.T) {
+	r.Equal(t, "monday", Monday.String())
+
+	text, e := Saturday.MarshalText()
+	r.NoError(t, e)
+	r.Equal(t, "saturday", string(text))
+
+	var day Weekday
+	r.NoError(t, day.UnmarshalText([]byte("Monday")))
+	r.Equal(t, Monday, day)
+	r.NoError(t, day.UnmarshalText([]byte("Mon")))
+	r.Equal(t, Weekday("mon"), day)
+
+	day, e = ParseWeekday("FRIDAY")
+	r.NoError(t, e)
+	r.Equal(t, Friday, day)
+	_, e = ParseWeekday("mon")
+	r.Equal(t, ErrInvalidWeekday("mon"), e)
+
+	config := struct {
+		Weekdays []Weekday `json:"weekdays"`
+	}{}
+	r.NoError(t, json.Unmarshal([]byte(`{"weekdays":["monday","FRIDAY"]}`), &config))
+	r.Equal(t, []Weekday{Monday, Friday}, config.Weekdays)
+}
+
+func TestPeriod_Text(t *testing.T) {
+	r.Equal(t, "week", PeriodWeek.String())
+	r.Equal(t, "active", Active.String())
+
+	text, e := PeriodMonth.MarshalText()
+	r.NoError(t, e)
+	r.Equal(t, "month", string(text))
+
+	var period Period
+	r.NoError(t, period.UnmarshalText([]byte("week")))
+	r.Equal(t, PeriodWeek, period)
+	r.NoError(t, period.UnmarshalText([]byte("Year")))
+	r.Equal(t, Period("year"), period)
+
+	period, e = ParsePeriod("Week")
+	r.NoError(t, e)
+	r.Equal(t, PeriodWeek, period)
+	_, e = ParsePeriod("year")
+	r.Equal(t, ErrInvalidPeriod("year"), e)
+
+	r.Equal(t, "every 3 week", fmt.Sprintf("every %d %s", 3, PeriodWeek))
+}
+
+func TestWeekdays_MarshalJSON(t *testing.T) {
+	buffer, e := json.Marshal(Weekdays{Saturday, "funday", Monday, Saturday})
+	r.NoError(t, e)
+	r.Equal(t, `["monday","saturday","funday"]`, string(buffer))
+
+	r.NoError(t, Weekdays{Monday, Saturday}.Validate())
+	r.Equal(t, ErrInvalidWeekday("funday"), Weekdays{Monday, "funday"}.Validate())
+}
@@ -1,5 +1,9 @@
 package schedule
 
+import (
+	"strings"
+)
+
 // Period represents an enumeration of possible period of a Schedule object.
 type Period string
 
@@ -9,3 +13,41 @@ const (
 	PeriodWeek  Period = "week"
 	PeriodMonth Period = "month"
 )
+
+// ErrInvalidPeriod is returned by ParsePeriod for a value that is not one of the predefined
+// constants.
+type ErrInvalidPeriod string
+
+func (e ErrInvalidPeriod) Error() string {
+	return "invalid period: " + string(e)
+}
+
+func (p Period) String() string {
+	return string(p)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler so that periods can be loaded from
+// configuration formats such as YAML. The text is lowercased but not validated, so that
+// decoding API responses never fails on a period unknown to this package. Use ParsePeriod
+// to reject unknown values when loading configuration.
+func (p *Period) UnmarshalText(text []byte) error {
+	*p = Period(strings.ToLower(string(text)))
+	return nil
+}
+
+// ParsePeriod returns the Period named by s, matched case-insensitively, or an
+// ErrInvalidPeriod if it is not one of the predefined constants.
+func ParsePeriod(s string) (Period, error) {
+	period := Period(strings.ToLower(s))
+	switch period {
+	case PeriodDay, PeriodWeek, PeriodMonth:
+		return period, nil
+	}
+
+	return "", ErrInvalidPeriod(s)
+}
@@ -0,0 +1,196 @@
+package schedule
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrUnsupportedRRule is returned by ParseRRule for recurrence rules that cannot be
+// expressed as an Omise schedule recurrence.
+var ErrUnsupportedRRule = errors.New("unsupported recurrence rule")
+
+var rruleWeekdays = map[Weekday]string{
+	Monday:    "MO",
+	Tuesday:   "TU",
+	Wednesday: "WE",
+	Thursday:  "TH",
+	Friday:    "FR",
+	Saturday:  "SA",
+	Sunday:    "SU",
+}
+
+var rruleOrdinals = map[string]string{
+	"1st":  "1",
+	"2nd":  "2",
+	"3rd":  "3",
+	"4th":  "4",
+	"last": "-1",
+}
+
+// RRule returns the RFC 5545 recurrence rule equivalent to a schedule's recurrence, e.g.
+// "FREQ=WEEKLY;INTERVAL=3;BYDAY=MO,SA" for every 3 weeks on Monday and Saturday. The rule
+// does not include the start and end dates. Weekdays are sorted from Monday to Sunday and
+// unknown weekdays are left out. Returns an empty string if the period is unknown.
+func RRule(every int, period Period, on On) string {
+	parts := []string{}
+	switch period {
+	case PeriodDay:
+		parts = append(parts, "FREQ=DAILY")
+	case PeriodWeek:
+		parts = append(parts, "FREQ=WEEKLY")
+	case PeriodMonth:
+		parts = append(parts, "FREQ=MONTHLY")
+	default:
+		return ""
+	}
+
+	parts = append(parts, "INTERVAL="+strconv.Itoa(every))
+
+	switch {
+	case period == PeriodWeek && len(on.Weekdays) > 0:
+		days := append(Weekdays{}, on.Weekdays...)
+		sort.Slice(days, func(i, j int) bool {
+			return weekdayOrder[days[i]] < weekdayOrder[days[j]]
+		})
+
+		seen, byDay := map[Weekday]bool{}, []string{}
+		for _, day := range days {
+			if abbr, ok := rruleWeekdays[day]; ok && !seen[day] {
+				seen[day] = true
+				byDay = append(byDay, abbr)
+			}
+		}
+		if len(byDay) > 0 {
+			parts = append(parts, "BYDAY="+strings.Join(byDay, ","))
+		}
+
+	case period == PeriodMonth && len(on.DaysOfMonth) > 0:
+		byMonthDay := make([]string, len(on.DaysOfMonth))
+		for i, day := range on.DaysOfMonth {
+			byMonthDay[i] = strconv.Itoa(day)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(byMonthDay, ","))
+
+	case period == PeriodMonth && on.WeekdayOfMonth != nil:
+		if ValidateWeekdayOfMonth(*on.WeekdayOfMonth) == nil {
+			split := strings.SplitN(*on.WeekdayOfMonth, "_", 2)
+			parts = append(parts, "BYDAY="+rruleOrdinals[split[0]]+rruleWeekdays[Weekday(split[1])])
+		}
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// ParseRRule parses an RFC 5545 recurrence rule, with or without the "RRULE:" prefix, into
+// a schedule recurrence. It is the inverse of RRule. Only the FREQ (DAILY, WEEKLY or
+// MONTHLY), INTERVAL, BYDAY and BYMONTHDAY parts are supported, as well as WKST=MO. Monthly
+// rules may use either BYMONTHDAY or a single ordinal BYDAY such as 2MO or -1FR. Rules with
+// other parts, such as COUNT or UNTIL, return ErrUnsupportedRRule; set the schedule's end
+// date separately instead.
+func ParseRRule(rrule string) (every int, period Period, on On, err error) {
+	values := map[string]string{}
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rrule), "RRULE:"), ";") {
+		split := strings.SplitN(part, "=", 2)
+		if len(split) != 2 {
+			return 0, "", On{}, ErrUnsupportedRRule
+		}
+		values[strings.ToUpper(split[0])] = strings.ToUpper(split[1])
+	}
+
+	every = 1
+	for key, value := range values {
+		switch key {
+		case "FREQ", "BYDAY", "BYMONTHDAY":
+		case "INTERVAL":
+			n, e := strconv.Atoi(value)
+			if e != nil || n < 1 {
+				return 0, "", On{}, ErrUnsupportedRRule
+			}
+			every = n
+		case "WKST":
+			if value != "MO" {
+				return 0, "", On{}, ErrUnsupportedRRule
+			}
+		default:
+			return 0, "", On{}, ErrUnsupportedRRule
+		}
+	}
+
+	byDay, hasByDay := values["BYDAY"]
+	byMonthDay, hasByMonthDay := values["BYMONTHDAY"]
+
+	switch values["FREQ"] {
+	case "DAILY":
+		if hasByDay || hasByMonthDay {
+			return 0, "", On{}, ErrUnsupportedRRule
+		}
+		return every, PeriodDay, On{}, nil
+
+	case "WEEKLY":
+		if hasByMonthDay {
+			return 0, "", On{}, ErrUnsupportedRRule
+		}
+		if hasByDay {
+			for _, abbr := range strings.Split(byDay, ",") {
+				day, ok := weekdayFromRRule(abbr)
+				if !ok {
+					return 0, "", On{}, ErrUnsupportedRRule
+				}
+				on.Weekdays = append(on.Weekdays, day)
+			}
+		}
+		return every, PeriodWeek, on, nil
+
+	case "MONTHLY":
+		switch {
+		case hasByDay && hasByMonthDay, !hasByDay && !hasByMonthDay:
+			return 0, "", On{}, ErrUnsupportedRRule
+
+		case hasByMonthDay:
+			for _, value := range strings.Split(byMonthDay, ",") {
+				day, e := strconv.Atoi(value)
+				if e != nil || day < 1 || day > 31 {
+					return 0, "", On{}, ErrUnsupportedRRule
+				}
+				on.DaysOfMonth = append(on.DaysOfMonth, day)
+			}
+
+		default:
+			if len(byDay) < 3 {
+				return 0, "", On{}, ErrUnsupportedRRule
+			}
+
+			ordinal, abbr := byDay[:len(byDay)-2], byDay[len(byDay)-2:]
+			day, ok := weekdayFromRRule(abbr)
+			if !ok {
+				return 0, "", On{}, ErrUnsupportedRRule
+			}
+
+			weekdayOfMonth := ""
+			for name, value := range rruleOrdinals {
+				if strings.TrimPrefix(ordinal, "+") == value {
+					weekdayOfMonth = name + "_" + string(day)
+				}
+			}
+			if weekdayOfMonth == "" {
+				return 0, "", On{}, ErrUnsupportedRRule
+			}
+			on.WeekdayOfMonth = &weekdayOfMonth
+		}
+		return every, PeriodMonth, on, nil
+	}
+
+	return 0, "", On{}, ErrUnsupportedRRule
+}
+
+func weekdayFromRRule(abbr string) (Weekday, bool) {
+	for day, value := range rruleWeekdays {
+		if value == abbr {
+			return day, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,82 @@
+package schedule_test
+
+import (
+	"testing"
+
+	. "github.com/omise/omise-go/schedule"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestRRule(t *testing.T) {
+	secondMonday, lastFriday := "2nd_monday", "last_friday"
+
+	tests := []struct {
+		every    int
+		period   Period
+		on       On
+		expected string
+	}{
+		{3, PeriodDay, On{}, "FREQ=DAILY;INTERVAL=3"},
+		{3, PeriodWeek, On{Weekdays: Weekdays{Saturday, Monday, Saturday}}, "FREQ=WEEKLY;INTERVAL=3;BYDAY=MO,SA"},
+		{1, PeriodWeek, On{}, "FREQ=WEEKLY;INTERVAL=1"},
+		{1, PeriodMonth, On{DaysOfMonth: DaysOfMonth{1, 15}}, "FREQ=MONTHLY;INTERVAL=1;BYMONTHDAY=1,15"},
+		{2, PeriodMonth, On{WeekdayOfMonth: &secondMonday}, "FREQ=MONTHLY;INTERVAL=2;BYDAY=2MO"},
+		{1, PeriodMonth, On{WeekdayOfMonth: &lastFriday}, "FREQ=MONTHLY;INTERVAL=1;BYDAY=-1FR"},
+		{1, Period("year"), On{}, ""},
+	}
+
+	for _, test := range tests {
+		r.Equal(t, test.expected, RRule(test.every, test.period, test.on))
+	}
+}
+
+func TestParseRRule(t *testing.T) {
+	secondMonday, lastFriday := "2nd_monday", "last_friday"
+
+	tests := []struct {
+		rrule  string
+		every  int
+		period Period
+		on     On
+	}{
+		{"FREQ=DAILY;INTERVAL=3", 3, PeriodDay, On{}},
+		{"RRULE:FREQ=DAILY", 1, PeriodDay, On{}},
+		{"FREQ=WEEKLY;INTERVAL=3;BYDAY=MO,SA", 3, PeriodWeek, On{Weekdays: Weekdays{Monday, Saturday}}},
+		{"freq=weekly;wkst=mo", 1, PeriodWeek, On{}},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,15", 1, PeriodMonth, On{DaysOfMonth: DaysOfMonth{1, 15}}},
+		{"FREQ=MONTHLY;INTERVAL=2;BYDAY=2MO", 2, PeriodMonth, On{WeekdayOfMonth: &secondMonday}},
+		{"FREQ=MONTHLY;BYDAY=-1FR", 1, PeriodMonth, On{WeekdayOfMonth: &lastFriday}},
+	}
+
+	for _, test := range tests {
+		every, period, on, e := ParseRRule(test.rrule)
+		r.NoError(t, e, test.rrule)
+		r.Equal(t, test.every, every, test.rrule)
+		r.Equal(t, test.period, period, test.rrule)
+		r.Equal(t, test.on, on, test.rrule)
+
+		if test.on.Weekdays != nil || test.on.DaysOfMonth != nil || test.on.WeekdayOfMonth != nil {
+			_, _, roundtrip, e := ParseRRule(RRule(every, period, on))
+			r.NoError(t, e)
+			r.Equal(t, on, roundtrip)
+		}
+	}
+
+	for _, rrule := range []string{
+		"",
+		"FREQ=YEARLY",
+		"FREQ=DAILY;COUNT=10",
+		"FREQ=WEEKLY;UNTIL=20180515T000000Z",
+		"FREQ=WEEKLY;INTERVAL=0",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;WKST=SU",
+		"FREQ=MONTHLY",
+		"FREQ=MONTHLY;BYDAY=MO",
+		"FREQ=MONTHLY;BYDAY=5MO",
+		"FREQ=MONTHLY;BYMONTHDAY=-1",
+		"FREQ=MONTHLY;BYMONTHDAY=1;BYDAY=1MO",
+	} {
+		_, _, _, e := ParseRRule(rrule)
+		r.Equal(t, ErrUnsupportedRRule, e, rrule)
+	}
+}
@@ -0,0 +1,151 @@
+package schedule
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRecurrence is returned by Dates when the recurrence cannot be simulated, e.g.
+// when every is less than 1 or the period is unknown.
+var ErrInvalidRecurrence = errors.New("invalid schedule recurrence")
+
+// maxPeriods bounds the number of periods Dates walks through, so that recurrences that
+// never match a date (e.g. the 31st of every February) terminate.
+const maxPeriods = 10000
+
+// Dates computes, on the client, the dates a schedule with the given recurrence would
+// occur on, starting from start and up to end inclusive. A zero end date means no end.
+// At most limit dates are returned. Only the year, month and day of start and end are
+// used and the dates are returned at midnight UTC.
+//
+// This mirrors the rules documented by Omise but is only an estimate: the actual dates
+// are decided by Omise when the schedule is created and are reported in the Schedule's
+// NextOccurrences field.
+func Dates(every int, period Period, on On, start, end time.Time, limit int) ([]time.Time, error) {
+	if every < 1 || limit < 0 {
+		return nil, ErrInvalidRecurrence
+	}
+
+	start = toDate(start)
+	if !end.IsZero() {
+		end = toDate(end)
+	}
+
+	var candidates func(k int) []time.Time
+	switch period {
+	case PeriodDay:
+		candidates = func(k int) []time.Time {
+			return []time.Time{start.AddDate(0, 0, k*every)}
+		}
+
+	case PeriodWeek:
+		weekStart := start.AddDate(0, 0, -weekdayOrder[FromTimeWeekday(start.Weekday())])
+		offsets := []int{}
+		for _, day := range on.Weekdays {
+			offset, ok := weekdayOrder[day]
+			if !ok {
+				return nil, ErrInvalidWeekday(day)
+			}
+			offsets = append(offsets, offset)
+		}
+		if len(offsets) == 0 {
+			offsets = append(offsets, weekdayOrder[FromTimeWeekday(start.Weekday())])
+		}
+		sort.Ints(offsets)
+
+		candidates = func(k int) []time.Time {
+			week := weekStart.AddDate(0, 0, 7*k*every)
+			result := make([]time.Time, len(offsets))
+			for i, offset := range offsets {
+				result[i] = week.AddDate(0, 0, offset)
+			}
+			return result
+		}
+
+	case PeriodMonth:
+		monthStart := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+		days := append([]int{}, on.DaysOfMonth...)
+		sort.Ints(days)
+
+		weekdayOfMonth := ""
+		if on.WeekdayOfMonth != nil {
+			weekdayOfMonth = *on.WeekdayOfMonth
+			if e := ValidateWeekdayOfMonth(weekdayOfMonth); e != nil {
+				return nil, e
+			}
+		}
+		if len(days) == 0 && weekdayOfMonth == "" {
+			days = append(days, start.Day())
+		}
+
+		candidates = func(k int) []time.Time {
+			month := monthStart.AddDate(0, k*every, 0)
+			if weekdayOfMonth != "" {
+				return []time.Time{nthWeekdayOfMonth(month, weekdayOfMonth)}
+			}
+
+			result := []time.Time{}
+			for _, day := range days {
+				date := month.AddDate(0, 0, day-1)
+				if date.Month() == month.Month() {
+					result = append(result, date)
+				}
+			}
+			return result
+		}
+
+	default:
+		return nil, ErrInvalidRecurrence
+	}
+
+	result := []time.Time{}
+	for k := 0; k < maxPeriods && len(result) < limit; k++ {
+		for _, date := range candidates(k) {
+			switch {
+			case date.Before(start):
+				continue
+			case !end.IsZero() && date.After(end), len(result) >= limit:
+				return result, nil
+			}
+
+			result = append(result, date)
+		}
+	}
+
+	return result, nil
+}
+
+func toDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// nthWeekdayOfMonth returns the date of a valid weekday of month value, e.g.
+// "2nd_monday", in the month starting at month.
+func nthWeekdayOfMonth(month time.Time, weekdayOfMonth string) time.Time {
+	parts := strings.SplitN(weekdayOfMonth, "_", 2)
+	day, _ := Weekday(parts[1]).ToTimeWeekday()
+
+	if parts[0] == "last" {
+		date := month.AddDate(0, 1, -1)
+		for date.Weekday() != day {
+			date = date.AddDate(0, 0, -1)
+		}
+		return date
+	}
+
+	date := month
+	for date.Weekday() != day {
+		date = date.AddDate(0, 0, 1)
+	}
+
+	for _, ordinal := range weekdayOfMonthOrdinals {
+		if ordinal == parts[0] {
+			return date
+		}
+		date = date.AddDate(0, 0, 7)
+	}
+
+	return date
+}
@@ -0,0 +1,76 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/omise/omise-go/schedule"
+	r "github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDates(t *testing.T) {
+	secondMonday, lastFriday := "2nd_monday", "last_friday"
+
+	tests := []struct {
+		name     string
+		every    int
+		period   Period
+		on       On
+		start    time.Time
+		end      time.Time
+		limit    int
+		expected []time.Time
+	}{
+		{
+			"every 3 days", 3, PeriodDay, On{},
+			date(2017, 5, 15), date(2017, 5, 24), 10,
+			[]time.Time{date(2017, 5, 15), date(2017, 5, 18), date(2017, 5, 21), date(2017, 5, 24)},
+		},
+		{
+			"limit", 1, PeriodDay, On{},
+			date(2017, 5, 15), time.Time{}, 2,
+			[]time.Time{date(2017, 5, 15), date(2017, 5, 16)},
+		},
+		{
+			// 2017-05-17 is a Wednesday
+			"every 2 weeks on monday and saturday", 2, PeriodWeek, On{Weekdays: Weekdays{Saturday, Monday}},
+			date(2017, 5, 17), date(2017, 6, 12), 10,
+			[]time.Time{date(2017, 5, 20), date(2017, 5, 29), date(2017, 6, 3), date(2017, 6, 12)},
+		},
+		{
+			"every month on the 1st and 31st", 1, PeriodMonth, On{DaysOfMonth: DaysOfMonth{31, 1}},
+			date(2017, 1, 15), date(2017, 4, 1), 10,
+			[]time.Time{date(2017, 1, 31), date(2017, 2, 1), date(2017, 3, 1), date(2017, 3, 31), date(2017, 4, 1)},
+		},
+		{
+			"every month on the second monday", 1, PeriodMonth, On{WeekdayOfMonth: &secondMonday},
+			date(2017, 5, 1), date(2017, 7, 31), 10,
+			[]time.Time{date(2017, 5, 8), date(2017, 6, 12), date(2017, 7, 10)},
+		},
+		{
+			"every 2 months on the last friday", 2, PeriodMonth, On{WeekdayOfMonth: &lastFriday},
+			date(2017, 5, 1), date(2017, 9, 30), 10,
+			[]time.Time{date(2017, 5, 26), date(2017, 7, 28), date(2017, 9, 29)},
+		},
+	}
+
+	for _, test := range tests {
+		dates, e := Dates(test.every, test.period, test.on, test.start, test.end, test.limit)
+		r.NoError(t, e, test.name)
+		r.Equal(t, test.expected, dates, test.name)
+	}
+
+	_, e := Dates(0, PeriodDay, On{}, date(2017, 5, 15), time.Time{}, 10)
+	r.Equal(t, ErrInvalidRecurrence, e)
+	_, e = Dates(1, Period("year"), On{}, date(2017, 5, 15), time.Time{}, 10)
+	r.Equal(t, ErrInvalidRecurrence, e)
+
+	// never matches, must still terminate
+	dates, e := Dates(12, PeriodMonth, On{DaysOfMonth: DaysOfMonth{30}}, date(2017, 2, 1), time.Time{}, 1)
+	r.NoError(t, e)
+	r.Empty(t, dates)
+}
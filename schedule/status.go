@@ -11,3 +11,7 @@ const (
 	Deleted   Status = "deleted"
 	Suspended Status = "suspended"
 )
+
+func (s Status) String() string {
+	return string(s)
+}
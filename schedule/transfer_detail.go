@@ -1,9 +1,13 @@
 package schedule
 
-// TransferDetail represents transfer detail for schedule object.
+import "github.com/omise/omise-go/internal"
+
+// TransferDetail represents transfer detail for schedule object. A transfer schedule
+// specifies either an Amount or a PercentageOfBalance, so both are pointers and only one
+// of them is non-nil.
 type TransferDetail struct {
-	Recipient           string `json:"recipient"`
-	Amount              *int   `json:"amount"`
-	PercentageOfBalance *int   `json:"percentage_of_balance"`
-	Currency            string `json:"currency"`
+	Recipient           string           `json:"recipient"`
+	Amount              *internal.Amount `json:"amount"`
+	PercentageOfBalance *float64         `json:"percentage_of_balance"`
+	Currency            string           `json:"currency"`
 }
@@ -0,0 +1,58 @@
+package omise_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/omise/omise-go"
+	"github.com/omise/omise-go/schedule"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestSchedule_OccurrencesSummary(t *testing.T) {
+	schd := &Schedule{}
+	schd.Occurrences.Total = 3
+	schd.Occurrences.Data = []*Occurrence{
+		{Status: schedule.OccurrenceSuccessful},
+		{Status: schedule.OccurrenceFailed},
+		{Status: schedule.OccurrenceSuccessful},
+	}
+
+	summary, ok := schd.OccurrencesSummary()
+	r.True(t, ok)
+	r.Equal(t, OccurrencesSummary{Total: 3, Successful: 2, Failed: 1}, summary)
+
+	schd.Occurrences.Total = 24
+	summary, ok = schd.OccurrencesSummary()
+	r.False(t, ok)
+	r.Equal(t, 24, summary.Total)
+}
+
+func TestSchedule_NoNextOccurrences(t *testing.T) {
+	for _, payload := range []string{
+		`{"object":"schedule","status":"deleted"}`,
+		`{"object":"schedule","status":"deleted","next_occurrences":null}`,
+		`{"object":"schedule","status":"expired","next_occurrences":[]}`,
+	} {
+		schd := &Schedule{}
+		r.NoError(t, json.Unmarshal([]byte(payload), schd), payload)
+		r.Empty(t, schd.NextOccurrences, payload)
+
+		next, ok := schd.NextOccurrenceDate()
+		r.False(t, ok, payload)
+		r.True(t, next.IsZero(), payload)
+		r.NotContains(t, schd.ToICal(), "VEVENT", payload)
+	}
+}
+
+func TestSchedule_UnknownEnums(t *testing.T) {
+	schd := &Schedule{}
+	e := json.Unmarshal([]byte(`{"object":"schedule","period":"year","on":{"weekdays":["Funday"]}}`), schd)
+	r.NoError(t, e)
+	r.Equal(t, schedule.Period("year"), schd.Period)
+	r.Equal(t, schedule.Weekdays{"funday"}, schd.On.Weekdays)
+
+	buffer, e := json.Marshal(schd)
+	r.NoError(t, e)
+	r.Contains(t, string(buffer), `"weekdays":["funday"]`)
+}
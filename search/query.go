@@ -0,0 +1,162 @@
+package search
+
+import (
+	"github.com/omise/omise-go"
+	"github.com/omise/omise-go/operations"
+)
+
+// Direction represents an enumeration of possible sort directions of a Query.
+type Direction string
+
+// Direction can be one of the following list of constants:
+const (
+	Asc  Direction = "asc"
+	Desc Direction = "desc"
+)
+
+// ErrUnsupportedOrder is returned when building a Query ordered by a field other than the
+// creation time, or in an unknown direction. Omise's Search API only orders results
+// chronologically or in reverse.
+type ErrUnsupportedOrder string
+
+func (e ErrUnsupportedOrder) Error() string {
+	return "unsupported search order: " + string(e)
+}
+
+// ErrUnknownFilter is returned when building a Query that filters on a key Omise does not
+// support for the query's scope.
+type ErrUnknownFilter string
+
+func (e ErrUnknownFilter) Error() string {
+	return "unknown search filter: " + string(e)
+}
+
+// filterKeys lists the filter keys Omise supports per scope. Scopes not listed here accept
+// any key.
+var filterKeys = map[omise.SearchScope][]string{
+	omise.ChargeScope: {
+		"amount", "authorized", "captured", "card_last_digits", "created",
+		"customer_present", "disputed", "failure_code", "refund_amount", "refunded",
+		"status", "voided",
+	},
+	omise.CustomerScope: {"created"},
+}
+
+// Query builds a Search operation step by step. Each method returns the Query itself so
+// that calls can be chained. Errors are reported by Search once the query is complete.
+//
+// Omise's Search API supports the scopes defined as omise.SearchScope constants. There is
+// no scope for schedules.
+//
+// Example:
+//
+//	op, e := search.NewQuery(omise.ChargeScope).
+//		Filter("status", "successful").
+//		Order("created", search.Desc).
+//		Search()
+//	if e != nil {
+//		panic(e)
+//	}
+//
+//	result := &omise.ChargeSearchResult{}
+//	if e := client.Do(result, op); e != nil {
+//		panic(e)
+//	}
+//
+type Query struct {
+	scope   omise.SearchScope
+	text    string
+	filters map[string]string
+	order   omise.Ordering
+	err     error
+}
+
+// NewQuery returns an empty Query on the given scope.
+func NewQuery(scope omise.SearchScope) *Query {
+	return &Query{scope: scope}
+}
+
+// Text sets the free-text part of the query, e.g. an ID or an e-mail address.
+func (q *Query) Text(text string) *Query {
+	q.text = text
+	return q
+}
+
+// Filter restricts results to those whose field key matches value. Filtering on the same
+// key again replaces the previous value. Keys are checked against those supported for
+// charges and customers; other scopes accept any key.
+func (q *Query) Filter(key, value string) *Query {
+	if !isFilterKey(q.scope, key) {
+		q.fail(ErrUnknownFilter(key))
+		return q
+	}
+
+	if q.filters == nil {
+		q.filters = map[string]string{}
+	}
+
+	q.filters[key] = value
+	return q
+}
+
+func isFilterKey(scope omise.SearchScope, key string) bool {
+	keys, ok := filterKeys[scope]
+	if !ok {
+		return true
+	}
+
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Order sorts results by the given field, which must be "created" or "created_at" as
+// Omise only orders search results by creation time.
+func (q *Query) Order(field string, direction Direction) *Query {
+	if field != "created" && field != "created_at" {
+		q.fail(ErrUnsupportedOrder(field))
+		return q
+	}
+
+	switch direction {
+	case Asc:
+		q.order = omise.Chronological
+	case Desc:
+		q.order = omise.ReverseChronological
+	default:
+		q.fail(ErrUnsupportedOrder(direction))
+	}
+
+	return q
+}
+
+// fail records e unless an earlier error was already recorded.
+func (q *Query) fail(e error) {
+	if q.err == nil {
+		q.err = e
+	}
+}
+
+// Search returns the Search operation described by the query, or the first error
+// encountered while building it.
+func (q *Query) Search() (*operations.Search, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+
+	filters := make(map[string]string, len(q.filters))
+	for key, value := range q.filters {
+		filters[key] = value
+	}
+
+	return &operations.Search{
+		Scope:   q.scope,
+		Query:   q.text,
+		Filters: filters,
+		Order:   q.order,
+	}, nil
+}
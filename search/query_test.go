@@ -0,0 +1,72 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/omise/omise-go"
+	"github.com/omise/omise-go/internal/testutil"
+	"github.com/omise/omise-go/operations"
+	. "github.com/omise/omise-go/search"
+	r "github.com/stretchr/testify/require"
+)
+
+func TestQuery(t *testing.T) {
+	op, e := NewQuery(omise.CustomerScope).
+		Text("john").
+		Filter("created", "2017-05-16").
+		Order("created_at", Desc).
+		Search()
+	r.NoError(t, e)
+	r.Equal(t, &operations.Search{
+		Scope:   omise.CustomerScope,
+		Query:   "john",
+		Filters: map[string]string{"created": "2017-05-16"},
+		Order:   omise.ReverseChronological,
+	}, op)
+
+	req, e := testutil.NewFixedClient(t).Request(op)
+	r.NoError(t, e)
+	query := req.URL.Query()
+	r.Equal(t, "customer", query.Get("scope"))
+	r.Equal(t, "john", query.Get("query"))
+	r.Equal(t, "2017-05-16", query.Get("filters[created]"))
+	r.Equal(t, "reverse_chronological", query.Get("order"))
+}
+
+func TestQuery_Order(t *testing.T) {
+	op, e := NewQuery(omise.ChargeScope).Order("created", Asc).Search()
+	r.NoError(t, e)
+	r.Equal(t, omise.Chronological, op.Order)
+
+	_, e = NewQuery(omise.ChargeScope).Order("amount", Desc).Search()
+	r.Equal(t, ErrUnsupportedOrder("amount"), e)
+
+	_, e = NewQuery(omise.ChargeScope).Order("created", Direction("up")).Search()
+	r.Equal(t, ErrUnsupportedOrder("up"), e)
+}
+
+func TestQuery_Filter(t *testing.T) {
+	op, e := NewQuery(omise.ChargeScope).
+		Filter("status", "successful").
+		Filter("card_last_digits", "4242").
+		Search()
+	r.NoError(t, e)
+	r.Equal(t, map[string]string{"status": "successful", "card_last_digits": "4242"}, op.Filters)
+
+	_, e = NewQuery(omise.ChargeScope).Filter("colour", "red").Search()
+	r.Equal(t, ErrUnknownFilter("colour"), e)
+
+	_, e = NewQuery(omise.CustomerScope).Filter("status", "successful").Search()
+	r.Equal(t, ErrUnknownFilter("status"), e)
+
+	_, e = NewQuery(omise.RecipientScope).Filter("kind", "individual").Search()
+	r.NoError(t, e)
+}
+
+func TestQuery_FirstError(t *testing.T) {
+	_, e := NewQuery(omise.ChargeScope).
+		Filter("colour", "red").
+		Order("amount", Desc).
+		Search()
+	r.Equal(t, ErrUnknownFilter("colour"), e)
+}
@@ -9,7 +9,7 @@ type Transaction struct {
 	Source string          `json:"source" pretty:""`
 	Type   TransactionType `json:"type" pretty:""`
 
-	Amount       int64     `json:"amount" pretty:""`
+	Amount       Amount    `json:"amount" pretty:""`
 	Currency     string    `json:"currency" pretty:""`
 	Transferable time.Time `json:"transferable" pretty:""`
 }
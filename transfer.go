@@ -9,11 +9,14 @@ type Transfer struct {
 
 	Sent     bool   `json:"sent" pretty:""`
 	Paid     bool   `json:"paid" pretty:""`
-	Fee      int64  `json:"fee" pretty:""`
-	Amount   int64  `json:"amount" pretty:""`
+	Fee      Amount `json:"fee" pretty:""`
+	Amount   Amount `json:"amount" pretty:""`
 	Currency string `json:"currency" pretty:""`
 
 	FailureCode    *string `json:"failure_code"`
 	FailureMessage *string `json:"failure_message"`
 	Transaction    *string `json:"transaction"`
+	Schedule       string  `json:"schedule"`
+
+	Metadata Metadata `json:"metadata"`
 }
@@ -8,6 +8,14 @@ import (
 	"github.com/omise/omise-go/internal/creds"
 )
 
+// DefaultMaxIdleConnsPerHost is the number of keep-alive connections per host kept by the
+// transport of clients created with NewClient. It is higher than net/http's default of 2
+// so that busy clients do not churn connections to the Omise hosts.
+const DefaultMaxIdleConnsPerHost = 16
+
+// transport is shared by all clients created with NewClient. It attempts HTTP/2, which
+// net/http otherwise disables when a custom TLS configuration is given. Set the client's
+// Transport field to use a differently tuned transport.
 var transport *http.Transport
 
 func init() {
@@ -26,5 +34,7 @@ func init() {
 			RootCAs:    pool,
 			MinVersion: tls.VersionTLS12,
 		},
+		ForceAttemptHTTP2:   true,
+		MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
 	}
 }
@@ -1,7 +1,7 @@
 package omise
 
 import (
-	"encoding/json"
+	"io/ioutil"
 	"net/http"
 )
 
@@ -23,8 +23,14 @@ type webhookHTTPHandler struct {
 }
 
 func (h *webhookHTTPHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	buffer, e := ioutil.ReadAll(req.Body)
+	if e != nil {
+		http.Error(resp, e.Error(), http.StatusBadRequest)
+		return
+	}
+
 	event := &Event{}
-	if e := json.NewDecoder(req.Body).Decode(event); e != nil {
+	if e := Unmarshal(buffer, event); e != nil {
 		http.Error(resp, e.Error(), http.StatusBadRequest)
 		return
 	}
@@ -36,6 +42,9 @@ func (h *webhookHTTPHandler) ServeHTTP(resp http.ResponseWriter, req *http.Reque
 // API calls for events. The returned handler will automatically consume the request body
 // and unmarshals an Event object from JSON for you.
 //
+// The handler does not verify the authenticity or age of the payload. Use Verify from the
+// webhook subpackage on the raw body first to check its signature and reject replays.
+//
 // See https://www.omise.co/api-webhooks for more information.
 func WebhookHTTPHandler(handler EventHandler) http.Handler {
 	return &webhookHTTPHandler{handler}
@@ -0,0 +1,113 @@
+// Package webhook verifies the authenticity and age of Omise's webhook payloads.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers sent by Omise along with each signed webhook payload.
+const (
+	SignatureHeader = "Omise-Signature"
+	TimestampHeader = "Omise-Signature-Timestamp"
+)
+
+// DefaultTolerance is the maximum age of a payload accepted by Verify unless overridden
+// with WithTolerance.
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	// ErrMissingSignature is returned when the signature or timestamp header is missing.
+	ErrMissingSignature = errors.New("webhook signature headers are missing")
+
+	// ErrInvalidSignature is returned when none of the signatures match the payload.
+	ErrInvalidSignature = errors.New("webhook signature does not match the payload")
+
+	// ErrInvalidTimestamp is returned when the timestamp header is not a unix timestamp.
+	ErrInvalidTimestamp = errors.New("webhook timestamp is invalid")
+
+	// ErrStaleTimestamp is returned when the payload was signed further from the current
+	// time than the tolerance allows, e.g. because it is being replayed.
+	ErrStaleTimestamp = errors.New("webhook timestamp is outside the tolerance")
+)
+
+// Option configures Verify.
+type Option func(*config)
+
+type config struct {
+	tolerance time.Duration
+}
+
+// WithTolerance sets the maximum difference between the payload's timestamp and the
+// current time. Zero disables the timestamp check.
+func WithTolerance(tolerance time.Duration) Option {
+	return func(c *config) {
+		c.tolerance = tolerance
+	}
+}
+
+// Verify checks that payload, the raw body of a webhook request, was signed by Omise with
+// the given webhook secret and that it was signed recently enough to not be a replay. The
+// header holds the request's headers.
+//
+// The signature is the hex encoded HMAC-SHA256 of the timestamp, a dot and the payload.
+// Several comma separated signatures may be sent while the secret is being rotated; the
+// payload is accepted if any of them matches. Pass the secret as raw bytes, decoding it
+// first if it is displayed base64 encoded.
+//
+// Example:
+//
+//	payload, _ := ioutil.ReadAll(req.Body)
+//	if e := webhook.Verify(payload, req.Header, secret, webhook.WithTolerance(5*time.Minute)); e != nil {
+//		http.Error(resp, e.Error(), http.StatusBadRequest)
+//		return
+//	}
+//
+func Verify(payload []byte, header http.Header, secret []byte, options ...Option) error {
+	c := &config{tolerance: DefaultTolerance}
+	for _, option := range options {
+		option(c)
+	}
+
+	signatures, timestamp := header.Get(SignatureHeader), header.Get(TimestampHeader)
+	if signatures == "" || timestamp == "" {
+		return ErrMissingSignature
+	}
+
+	seconds, e := strconv.ParseInt(timestamp, 10, 64)
+	if e != nil {
+		return ErrInvalidTimestamp
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	valid := false
+	for _, signature := range strings.Split(signatures, ",") {
+		actual, e := hex.DecodeString(strings.TrimSpace(signature))
+		if e == nil && hmac.Equal(actual, expected) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	if c.tolerance > 0 {
+		age := time.Since(time.Unix(seconds, 0))
+		if age > c.tolerance || age < -c.tolerance {
+			return ErrStaleTimestamp
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/omise/omise-go/webhook"
+	r "github.com/stretchr/testify/require"
+)
+
+var (
+	secret  = []byte("whsec_test")
+	payload = []byte(`{"object":"event","key":"charge.create"}`)
+)
+
+func sign(secret []byte, t time.Time) http.Header {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+
+	header := http.Header{}
+	header.Set(SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	header.Set(TimestampHeader, timestamp)
+	return header
+}
+
+func TestVerify(t *testing.T) {
+	r.NoError(t, Verify(payload, sign(secret, time.Now()), secret))
+
+	// any of the signatures sent during a secret rotation
+	header := sign(secret, time.Now())
+	header.Set(SignatureHeader, "deadbeef,"+header.Get(SignatureHeader))
+	r.NoError(t, Verify(payload, header, secret))
+}
+
+func TestVerify_InvalidSignature(t *testing.T) {
+	r.Equal(t, ErrInvalidSignature, Verify(payload, sign([]byte("other"), time.Now()), secret))
+	r.Equal(t, ErrInvalidSignature, Verify([]byte(`{"object":"event"}`), sign(secret, time.Now()), secret))
+	r.Equal(t, ErrMissingSignature, Verify(payload, http.Header{}, secret))
+
+	header := sign(secret, time.Now())
+	header.Set(TimestampHeader, "yesterday")
+	r.Equal(t, ErrInvalidTimestamp, Verify(payload, header, secret))
+}
+
+func TestVerify_StaleTimestamp(t *testing.T) {
+	header := sign(secret, time.Now().Add(-10*time.Minute))
+	r.Equal(t, ErrStaleTimestamp, Verify(payload, header, secret))
+	r.Equal(t, ErrStaleTimestamp, Verify(payload, header, secret, WithTolerance(time.Minute)))
+	r.NoError(t, Verify(payload, header, secret, WithTolerance(time.Hour)))
+	r.NoError(t, Verify(payload, header, secret, WithTolerance(0)))
+}